@@ -0,0 +1,92 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTFEOrganizationRunTask() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEOrganizationRunTaskRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"category": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization, err := config.organization(d)
+	if err != nil {
+		return err
+	}
+
+	// Create a new options struct.
+	options := tfe.RunTaskListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+	}
+
+	for {
+		log.Printf("[DEBUG] List run tasks of organization: %s", organization)
+		tasks, err := tfeClient.RunTasks.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error listing run tasks of organization %s: %v", organization, err)
+		}
+
+		for _, task := range tasks {
+			if task.Name == name {
+				d.SetId(task.ID)
+				d.Set("url", task.URL)
+				d.Set("description", task.Description)
+				d.Set("category", task.Category)
+				d.Set("enabled", task.Enabled)
+				return nil
+			}
+		}
+
+		// Stop when we received less results than requested.
+		if len(tasks) < options.PageSize {
+			break
+		}
+		options.PageNumber++
+	}
+
+	return fmt.Errorf("Could not find run task %s in organization %s", name, organization)
+}
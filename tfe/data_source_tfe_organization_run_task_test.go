@@ -0,0 +1,53 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTFEOrganizationRunTaskDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckRunTask(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEOrganizationRunTaskDataSource_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_organization_run_task.foobar", "id",
+						"tfe_organization_run_task.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_run_task.foobar", "name", "run-task-test"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_run_task.foobar", "url", os.Getenv("TFE_RUN_TASK_URL")),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_run_task.foobar", "category", "task"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_organization_run_task.foobar", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTFEOrganizationRunTaskDataSource_basic() string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization_run_task" "foobar" {
+  name = "run-task-test"
+  organization = "${tfe_organization.foobar.id}"
+  url = "%s"
+}
+
+data "tfe_organization_run_task" "foobar" {
+  name = "${tfe_organization_run_task.foobar.name}"
+  organization = "${tfe_organization.foobar.id}"
+}`, os.Getenv("TFE_RUN_TASK_URL"))
+}
@@ -0,0 +1,70 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTFEProject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFEProjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization, err := config.organization(d)
+	if err != nil {
+		return err
+	}
+
+	// Create a new options struct.
+	options := tfe.ProjectListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Name:        name,
+	}
+
+	for {
+		log.Printf("[DEBUG] List projects of organization: %s", organization)
+		projects, err := tfeClient.Projects.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error listing projects of organization %s: %v", organization, err)
+		}
+
+		// The name filter matches partial names, so look for an exact match.
+		for _, project := range projects {
+			if project.Name == name {
+				d.SetId(project.ID)
+				return nil
+			}
+		}
+
+		// Stop when we received less results than requested.
+		if len(projects) < options.PageSize {
+			break
+		}
+		options.PageNumber++
+	}
+
+	return fmt.Errorf("Could not find project %s in organization %s", name, organization)
+}
@@ -0,0 +1,41 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTFEProjectDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEProjectDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_project.foobar", "id", "tfe_project.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_project.foobar", "name", "project-test"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTFEProjectDataSource_basic = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name = "project-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+data "tfe_project" "foobar" {
+  name = "${tfe_project.foobar.name}"
+  organization = "${tfe_organization.foobar.id}"
+}`
@@ -0,0 +1,124 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTFEWorkspaceIDs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTFEWorkspaceIDsRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"tag_names": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"external_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTFEWorkspaceIDsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the organization.
+	organization, err := config.organization(d)
+	if err != nil {
+		return err
+	}
+
+	// Create a map with all the names we are looking for.
+	names := make(map[string]bool)
+	for _, name := range d.Get("names").([]interface{}) {
+		names[name.(string)] = true
+	}
+
+	// Collect the tags all workspaces need to have.
+	var tagNames []string
+	for _, tagName := range d.Get("tag_names").(*schema.Set).List() {
+		tagNames = append(tagNames, tagName.(string))
+	}
+	sort.Strings(tagNames)
+
+	if len(names) == 0 && len(tagNames) == 0 {
+		return fmt.Errorf("At least one of names or tag_names must be set")
+	}
+
+	// Without any names, all workspaces matching the tags are returned.
+	if len(names) == 0 {
+		names["*"] = true
+	}
+
+	// Create a new options struct.
+	options := tfe.WorkspaceListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+		Tags:        strings.Join(tagNames, ","),
+	}
+
+	ids := make(map[string]string)
+	externalIDs := make(map[string]string)
+	for {
+		log.Printf("[DEBUG] List workspaces of organization: %s", organization)
+		workspaces, err := tfeClient.Workspaces.List(ctx, organization, options)
+		if err != nil {
+			return fmt.Errorf("Error listing workspaces of organization %s: %v", organization, err)
+		}
+
+		for _, w := range workspaces {
+			if names["*"] || names[w.Name] {
+				ids[w.Name] = packWorkspaceID(w)
+				externalIDs[w.Name] = w.ID
+			}
+		}
+
+		// Stop when we received less results than requested.
+		if len(workspaces) < options.PageSize {
+			break
+		}
+		options.PageNumber++
+	}
+
+	d.Set("ids", ids)
+	d.Set("external_ids", externalIDs)
+
+	// Build a stable ID from the organization and the used filters.
+	var keys []string
+	for name := range names {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	filter := strings.Join(keys, ",") + "|" + options.Tags
+	d.SetId(fmt.Sprintf("%s/%d", organization, hashcode.String(filter)))
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package tfe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccTFEWorkspaceIDsDataSource_names(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEWorkspaceIDsDataSource_names,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.foobar", "ids.%", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_ids.foobar", "ids.workspace-foo",
+						"tfe_workspace.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.foobar", "external_ids.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspaceIDsDataSource_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEWorkspaceIDsDataSource_tags,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.tfe_workspace_ids.foobar", "ids.%", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.tfe_workspace_ids.foobar", "ids.workspace-bar",
+						"tfe_workspace.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTFEWorkspaceIDsDataSource_names = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foo" {
+  name = "workspace-foo"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_workspace" "bar" {
+  name = "workspace-bar"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+data "tfe_workspace_ids" "foobar" {
+  names = ["${tfe_workspace.foo.name}"]
+  organization = "${tfe_organization.foobar.id}"
+}`
+
+const testAccTFEWorkspaceIDsDataSource_tags = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foo" {
+  name = "workspace-foo"
+  organization = "${tfe_organization.foobar.id}"
+  tag_names = ["team:platform"]
+}
+
+resource "tfe_workspace" "bar" {
+  name = "workspace-bar"
+  organization = "${tfe_organization.foobar.id}"
+  tag_names = ["team:payments", "env:prod"]
+}
+
+data "tfe_workspace_ids" "foobar" {
+  tag_names = ["team:payments"]
+  organization = "${tfe_organization.foobar.id}"
+  depends_on = ["tfe_workspace.foo", "tfe_workspace.bar"]
+}`
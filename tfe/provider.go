@@ -2,14 +2,27 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
 
 	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/svchost"
 	"github.com/hashicorp/terraform/svchost/auth"
 	"github.com/hashicorp/terraform/svchost/disco"
@@ -23,8 +36,9 @@ const (
 
 // Config is the structure of the configuration for the Terraform CLI.
 type Config struct {
-	Hosts       map[string]*ConfigHost            `hcl:"host"`
-	Credentials map[string]map[string]interface{} `hcl:"credentials"`
+	Hosts              map[string]*ConfigHost              `hcl:"host"`
+	Credentials        map[string]map[string]interface{}   `hcl:"credentials"`
+	CredentialsHelpers map[string]*ConfigCredentialsHelper `hcl:"credentials_helper"`
 }
 
 // ConfigHost is the structure of the "host" nested block within the CLI
@@ -34,52 +48,360 @@ type ConfigHost struct {
 	Services map[string]interface{} `hcl:"services"`
 }
 
-// ctx is used as default context.Context when making TFE calls.
-var ctx = context.Background()
+// ConfiguredClient wraps the TFE client together with the provider settings
+// that are needed by resources and data sources.
+type ConfiguredClient struct {
+	// Client is the client used to make TFE calls.
+	Client *tfe.Client
+
+	// AdminClient is the client used for the site-admin API. It is only set
+	// when an admin token is configured.
+	AdminClient *tfe.Client
+
+	// HTTPClient is used for requests that are not made through the TFE
+	// client, like uploads to the registry.
+	HTTPClient *http.Client
+
+	// Organization is the default organization used when a resource or data
+	// source does not set its own organization.
+	Organization string
+
+	// StopContext is used for all TFE calls. It is canceled when Terraform
+	// stops the provider, e.g. when the user interrupts an apply.
+	StopContext context.Context
+}
+
+// contextWithTimeout returns the context for the TFE calls of a resource
+// operation, which is canceled when the provider is stopped or when the
+// timeout configured for the operation expires.
+func (c *ConfiguredClient) contextWithTimeout(d *schema.ResourceData, key string) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(c.StopContext, d.Timeout(key))
+}
+
+// organization returns the organization configured for a resource or data
+// source, or the default organization of the provider when none is set.
+func (c *ConfiguredClient) organization(d *schema.ResourceData) (string, error) {
+	if organization := d.Get("organization").(string); organization != "" {
+		return organization, nil
+	}
+	if c.Organization == "" {
+		return "", errMissingOrganization
+	}
+	return c.Organization, nil
+}
+
+// errMissingOrganization is returned when neither a resource nor the provider
+// configures an organization.
+var errMissingOrganization = fmt.Errorf(
+	"No organization was set: set the organization argument, or configure a default " +
+		"organization with the provider's organization argument or the TFE_ORGANIZATION " +
+		"environment variable")
+
+// adminClient returns the client used for the site-admin API. Resources that
+// manage site-admin settings use this client instead of Client, so the token
+// used for all other resources does not need site-admin permissions.
+func (c *ConfiguredClient) adminClient() (*tfe.Client, error) {
+	if c.AdminClient == nil {
+		return nil, errMissingAdminToken
+	}
+	return c.AdminClient, nil
+}
+
+// errMissingAdminToken is returned when a resource uses the site-admin API
+// while no admin token is configured.
+var errMissingAdminToken = fmt.Errorf(
+	"This resource uses the site-admin API which requires an admin token: set the " +
+		"provider's admin_token argument or the TFE_ADMIN_TOKEN environment variable")
+
+// customizeDiffAdminToken reports a missing admin token when planning a
+// resource that uses the site-admin API, instead of when applying.
+func customizeDiffAdminToken(d *schema.ResourceDiff, meta interface{}) error {
+	_, err := meta.(*ConfiguredClient).adminClient()
+	return err
+}
+
+// customizeDiffOrganization sets the organization of a resource to the
+// default organization of the provider when the resource does not set its
+// own organization. This way a missing organization is reported when
+// planning instead of when applying, and an existing resource is replaced
+// when the default organization of the provider changes.
+func customizeDiffOrganization(d *schema.ResourceDiff, meta interface{}) error {
+	// Configured organizations, including unknown ones, are used as is.
+	if organizationConfigured(d) {
+		return nil
+	}
+
+	organization := meta.(*ConfiguredClient).Organization
+	if organization == "" {
+		// Keep the organization of existing resources, like imported ones.
+		if d.Get("organization").(string) != "" {
+			return nil
+		}
+		return errMissingOrganization
+	}
+
+	if d.Get("organization").(string) == organization {
+		return nil
+	}
+
+	if err := d.SetNew("organization", organization); err != nil {
+		return err
+	}
+
+	// The organization of a new resource is set when creating it.
+	if d.Id() == "" {
+		return nil
+	}
+
+	return d.ForceNew("organization")
+}
+
+// organizationConfigured returns whether the configuration of a resource
+// sets its organization. A ResourceDiff only exposes the configuration merged
+// with the state, in which an organization from the state looks the same as
+// a configured one, so the configuration is read from the unexported field.
+func organizationConfigured(d *schema.ResourceDiff) bool {
+	field := reflect.ValueOf(d).Elem().FieldByName("config")
+	if !field.IsValid() || field.IsNil() {
+		// Never replace a resource when the configuration can't be read.
+		return true
+	}
+
+	c, ok := reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Interface().(*terraform.ResourceConfig)
+	if !ok {
+		return true
+	}
+
+	_, ok = c.Get("organization")
+	return ok
+}
+
+// ConfigCredentialsHelper is the structure of the "credentials_helper"
+// nested block within the CLI configuration.
+type ConfigCredentialsHelper struct {
+	Args []string `hcl:"args"`
+}
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hostname": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["hostname"],
-				Default:     defaultHostname,
+				DefaultFunc: schema.EnvDefaultFunc("TFE_HOSTNAME", defaultHostname),
+			},
+
+			"address": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["address"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_ADDRESS", nil),
+			},
+
+			"base_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["base_path"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_BASE_PATH", nil),
 			},
 
 			"token": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["token"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_TOKEN", ""),
+			},
+
+			"admin_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["admin_token"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_ADMIN_TOKEN", nil),
+			},
+
+			"organization": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["organization"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_ORGANIZATION", ""),
+			},
+
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["max_retries"],
+				Default:     defaultMaxRetries,
+			},
+
+			"retry_wait_min": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["retry_wait_min"],
+				Default:     defaultRetryWaitMin,
+			},
+
+			"retry_wait_max": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["retry_wait_max"],
+				Default:     defaultRetryWaitMax,
+			},
+
+			"ssl_skip_verify": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["ssl_skip_verify"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_SSL_SKIP_VERIFY", ""),
+			},
+
+			"ca_cert_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_file"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_CA_CERT_FILE", ""),
+			},
+
+			"ca_cert_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["ca_cert_pem"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_CA_CERT_PEM", ""),
+			},
+
+			"client_cert_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_cert_file"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_CLIENT_CERT_FILE", ""),
 			},
+
+			"client_cert_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_cert_pem"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_CLIENT_CERT_PEM", ""),
+			},
+
+			"client_key_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["client_key_file"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_CLIENT_KEY_FILE", ""),
+			},
+
+			"client_key_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["client_key_pem"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_CLIENT_KEY_PEM", ""),
+			},
+
+			"proxy_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: descriptions["proxy_url"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_PROXY_URL", ""),
+			},
+
+			"no_proxy": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"requests_per_second": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Description:  descriptions["requests_per_second"],
+				Default:      defaultRequestsPerSecond,
+				ValidateFunc: floatAtLeast(0),
+			},
+
+			"requests_burst": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  descriptions["requests_burst"],
+				Default:      defaultRequestsBurst,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"audit_log_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["audit_log_path"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_AUDIT_LOG_PATH", nil),
+			},
+
+			"read_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["read_only"],
+				DefaultFunc: schema.EnvDefaultFunc("TFE_READ_ONLY", false),
+			},
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"tfe_organization_run_task": dataSourceTFEOrganizationRunTask(),
+			"tfe_project":               dataSourceTFEProject(),
+			"tfe_workspace_ids":         dataSourceTFEWorkspaceIDs(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"tfe_organization":       resourceTFEOrganization(),
-			"tfe_organization_vcs":   resourceTFEOrganizationVCS(),
-			"tfe_organization_token": resourceTFEOrganizationToken(),
-			"tfe_sentinel_policy":    resourceTFESentinelPolicy(),
-			"tfe_ssh_key":            resourceTFESSHKey(),
-			"tfe_team":               resourceTFETeam(),
-			"tfe_team_access":        resourceTFETeamAccess(),
-			"tfe_team_member":        resourceTFETeamMember(),
-			"tfe_team_members":       resourceTFETeamMembers(),
-			"tfe_team_token":         resourceTFETeamToken(),
-			"tfe_workspace":          resourceTFEWorkspace(),
-			"tfe_variable":           resourceTFEVariable(),
-			"tfe_registry_module":    resourceTFERegistryModule(),
+			"tfe_admin_organization_settings":      resourceTFEAdminOrganizationSettings(),
+			"tfe_organization":                     resourceTFEOrganization(),
+			"tfe_organization_vcs":                 resourceTFEOrganizationVCS(),
+			"tfe_organization_token":               resourceTFEOrganizationToken(),
+			"tfe_organization_run_task":            resourceTFEOrganizationRunTask(),
+			"tfe_project":                          resourceTFEProject(),
+			"tfe_sentinel_policy":                  resourceTFESentinelPolicy(),
+			"tfe_ssh_key":                          resourceTFESSHKey(),
+			"tfe_team":                             resourceTFETeam(),
+			"tfe_team_access":                      resourceTFETeamAccess(),
+			"tfe_team_member":                      resourceTFETeamMember(),
+			"tfe_team_members":                     resourceTFETeamMembers(),
+			"tfe_team_project_access":              resourceTFETeamProjectAccess(),
+			"tfe_team_token":                       resourceTFETeamToken(),
+			"tfe_workspace":                        resourceTFEWorkspace(),
+			"tfe_workspace_remote_state_consumers": resourceTFEWorkspaceRemoteStateConsumers(),
+			"tfe_workspace_run_task":               resourceTFEWorkspaceRunTask(),
+			"tfe_variable":                         resourceTFEVariable(),
+			"tfe_registry_module":                  resourceTFERegistryModule(),
+			"tfe_registry_provider":                resourceTFERegistryProvider(),
+			"tfe_registry_provider_version":        resourceTFERegistryProviderVersion(),
+			"tfe_registry_provider_platform":       resourceTFERegistryProviderPlatform(),
+			"tfe_registry_gpg_key":                 resourceTFERegistryGPGKey(),
 		},
+	}
 
-		ConfigureFunc: providerConfigure,
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.StopContext())
 	}
+
+	return provider
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, stopCtx context.Context) (interface{}, error) {
 	// Get the hostname and token.
 	hostname := d.Get("hostname").(string)
 	token := d.Get("token").(string)
 
+	// When an address is configured, the credentials are looked up for the
+	// host of that address instead of the configured hostname.
+	address := d.Get("address").(string)
+	if address != "" {
+		u, err := url.Parse(address)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("Invalid address %q: must be an absolute URL", address)
+		}
+		hostname = u.Host
+	}
+
 	// Parse the hostname for comparison,
 	host, err := svchost.ForComparison(hostname)
 	if err != nil {
@@ -89,9 +411,32 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	// Get the Terraform CLI configuration.
 	config := cliConfig()
 
+	// Get the TLS configuration.
+	tlsCfg, err := tlsConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proxy configuration.
+	var noProxy []string
+	for _, host := range d.Get("no_proxy").([]interface{}) {
+		noProxy = append(noProxy, host.(string))
+	}
+	proxy, err := proxyFunc(d.Get("proxy_url").(string), noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a transport that is used for both service discovery and API
+	// calls, so they connect to Terraform Enterprise in the same way.
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = tlsCfg
+	transport.Proxy = proxy
+
 	// Create a new credential source and service discovery object.
 	credsSrc := credentialsSource(config)
 	services := disco.NewWithCredentialsSource(credsSrc)
+	services.Transport = transport
 
 	// Add any static host configurations service discovery object.
 	for userHost, hostConfig := range config.Hosts {
@@ -103,14 +448,25 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		services.ForceHostServices(host, hostConfig.Services)
 	}
 
-	// Discover the full Terraform Enterprise service address.
-	address := services.DiscoverServiceURL(host, serviceID)
-	if address == nil {
-		return nil, fmt.Errorf("host %s does not provide a Terraform Enterprise API", host)
+	// Discover the full Terraform Enterprise service address, unless the
+	// address was configured explicitly.
+	if address == "" {
+		serviceURL, err := discoverServiceURL(services, host)
+		if err != nil {
+			return nil, err
+		}
+		address = serviceURL.String()
+	}
+
+	// When no token was set in the provider configuration or the TFE_TOKEN
+	// environment variable, look for a host specific environment variable
+	// the same way Terraform does.
+	if token == "" {
+		token = tokenFromEnv(host)
 	}
 
 	// Only try to get to the token from the credentials source if no token
-	// was explicitly set in the provider configuration.
+	// was explicitly set in the provider configuration or environment.
 	if token == "" {
 		creds, err := services.CredentialsForHost(host)
 		if err != nil {
@@ -123,80 +479,498 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	// If we still don't have a token at this point, we return an error.
 	if token == "" {
-		return nil, fmt.Errorf("required token could not be found")
+		return nil, fmt.Errorf(
+			"Required token could not be found. Tried, in order: the token provider argument, "+
+				"the TFE_TOKEN environment variable, the %s environment variable, and the "+
+				"credentials for %s in the CLI config file, the credentials.tfrc.json file and "+
+				"the credentials helper", tokenEnvName(host), host)
+	}
+
+	// Get the base URL of the API, to tell API requests apart from requests
+	// to other URLs, like the pre-signed upload and download URLs.
+	apiURL, err := apiBaseURL(address, d.Get("base_path").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a HTTP client that retries rate limited and failed requests.
+	httpClient := &http.Client{Transport: transport}
+
+	// Log every request and response, with all secrets redacted, when debug
+	// logging is enabled.
+	if logging.IsDebugOrHigher() {
+		httpClient.Transport = &loggingTransport{
+			transport: httpClient.Transport,
+			apiURL:    apiURL,
+		}
+	}
+
+	// Limit the request rate, including any retries, of this provider.
+	if rps := d.Get("requests_per_second").(float64); rps > 0 {
+		httpClient.Transport = &rateLimitTransport{
+			transport: httpClient.Transport,
+			limiter:   newTokenBucket(rps, d.Get("requests_burst").(int)),
+		}
+	}
+
+	maxRetries := d.Get("max_retries").(int)
+	if maxRetries < 0 {
+		return nil, fmt.Errorf("max_retries must be 0 or more, got: %d", maxRetries)
+	}
+	retryWaitMin := d.Get("retry_wait_min").(int)
+	retryWaitMax := d.Get("retry_wait_max").(int)
+	if retryWaitMin > retryWaitMax {
+		return nil, fmt.Errorf(
+			"retry_wait_min (%d) must not be greater than retry_wait_max (%d)", retryWaitMin, retryWaitMax)
+	}
+
+	httpClient.Transport = &retryTransport{
+		transport:  httpClient.Transport,
+		maxRetries: maxRetries,
+		waitMin:    time.Duration(retryWaitMin) * time.Second,
+		waitMax:    time.Duration(retryWaitMax) * time.Second,
+	}
+
+	// Record every change made by this provider in the audit log. This wraps
+	// the retries, so a retried request is only recorded once.
+	if auditLogPath := d.Get("audit_log_path").(string); auditLogPath != "" {
+		if err := checkAuditLog(auditLogPath); err != nil {
+			return nil, err
+		}
+		httpClient.Transport = &auditTransport{
+			transport: httpClient.Transport,
+			apiURL:    apiURL,
+			log:       &auditLog{path: auditLogPath},
+		}
+	}
+
+	// Refuse all requests that could change anything when configured to be
+	// read only. Refused requests are never sent, so they are not audited.
+	if d.Get("read_only").(bool) {
+		httpClient.Transport = &readOnlyTransport{
+			transport: httpClient.Transport,
+			apiURL:    apiURL,
+		}
 	}
 
 	// Create a new TFE client config..
 	cfg := &tfe.Config{
-		Address: address.String(),
-		Token:   token,
+		Address:    address,
+		BasePath:   d.Get("base_path").(string),
+		Token:      token,
+		HTTPClient: httpClient,
 	}
 
 	// Create s new TFE client.
-	return tfe.NewClient(cfg)
+	client, err := tfe.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a second client for the site-admin API when an admin token is
+	// configured. It connects the same way, but uses a different identity.
+	var adminClient *tfe.Client
+	if adminToken := d.Get("admin_token").(string); adminToken != "" {
+		adminCfg := *cfg
+		adminCfg.Token = adminToken
+
+		adminClient, err = tfe.NewClient(&adminCfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ConfiguredClient{
+		Client:       client,
+		AdminClient:  adminClient,
+		HTTPClient:   httpClient,
+		Organization: d.Get("organization").(string),
+		StopContext:  stopCtx,
+	}, nil
+}
+
+// apiBaseURL returns the URL on which the API is served, which is the
+// address combined with the base path, the same way the TFE client does.
+func apiBaseURL(address, basePath string) (*url.URL, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid address %q: %v", address, err)
+	}
+
+	if basePath == "" {
+		basePath = tfe.DefaultBasePath
+	}
+	if !strings.HasSuffix(basePath, "/") {
+		basePath += "/"
+	}
+	u.Path = basePath
+
+	return u, nil
+}
+
+// discoveryCache holds the discovered API addresses per hostname, so service
+// discovery only runs once per host for all provider instances in a process.
+var discoveryCache = struct {
+	sync.Mutex
+	addresses map[svchost.Hostname]*url.URL
+}{
+	addresses: make(map[svchost.Hostname]*url.URL),
+}
+
+// discoverServiceURL returns the Terraform Enterprise API address of the
+// given host, using the cached result of an earlier discovery if available.
+func discoverServiceURL(services *disco.Disco, host svchost.Hostname) (*url.URL, error) {
+	discoveryCache.Lock()
+	defer discoveryCache.Unlock()
+
+	if address, ok := discoveryCache.addresses[host]; ok {
+		log.Printf("[DEBUG] Using cached service discovery result for %s: %s", host, address)
+		return address, nil
+	}
+
+	address := services.DiscoverServiceURL(host, serviceID)
+	if address == nil {
+		return nil, fmt.Errorf("host %s does not provide a Terraform Enterprise API", host)
+	}
+	discoveryCache.addresses[host] = address
+
+	return address, nil
 }
 
 // cliConfig tries to find and parse the configuration of the Terraform CLI.
-// This is an optional step, so any errors are ignored.
+// Like Terraform, the main CLI config file is merged with any *.tfrc files in
+// the CLI configuration directory. This is an optional step, so any errors
+// are ignored.
 func cliConfig() *Config {
 	config := &Config{}
 
-	// Detect the CLI config file path.
-	configFilePath := os.Getenv("TERRAFORM_CONFIG")
+	// Detect the CLI config file path. Like Terraform, only the configured
+	// file is loaded when its location is overridden.
+	configFilePath := os.Getenv("TF_CLI_CONFIG_FILE")
+	if configFilePath == "" {
+		configFilePath = os.Getenv("TERRAFORM_CONFIG")
+	}
+	overridden := configFilePath != ""
 	if configFilePath == "" {
 		filePath, err := configFile()
 		if err != nil {
 			log.Printf("[ERROR] Error detecting default CLI config file path: %s", err)
-			return config
 		}
 		configFilePath = filePath
 	}
 
-	// Read the CLI config file content.
-	content, err := ioutil.ReadFile(configFilePath)
+	// Load the main CLI config file.
+	if configFilePath != "" {
+		if mainConfig, err := loadCLIConfigFile(configFilePath); err != nil {
+			log.Printf("[ERROR] %v", err)
+		} else {
+			config = config.merge(mainConfig)
+		}
+	}
+
+	if overridden {
+		return config
+	}
+
+	// Detect the CLI config directory path.
+	dir, err := configDir()
 	if err != nil {
-		log.Printf("[ERROR] Error reading the CLI config file %s: %v", configFilePath, err)
+		log.Printf("[ERROR] Error detecting default CLI config directory: %s", err)
 		return config
 	}
 
+	// Merge in all *.tfrc files from the CLI config directory.
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[ERROR] Error reading the CLI config directory %s: %v", dir, err)
+		}
+		return config
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".tfrc" {
+			continue
+		}
+
+		fileConfig, err := loadCLIConfigFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			log.Printf("[ERROR] %v", err)
+			continue
+		}
+		config = config.merge(fileConfig)
+	}
+
+	return config
+}
+
+// loadCLIConfigFile reads and parses a single CLI config file.
+func loadCLIConfigFile(path string) (*Config, error) {
+	config := &Config{}
+
+	// Read the CLI config file content.
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the CLI config file %s: %v", path, err)
+	}
+
 	// Parse the CLI config file content.
 	obj, err := hcl.Parse(string(content))
 	if err != nil {
-		log.Printf("[ERROR] Error parsing the CLI config file %s: %v", configFilePath, err)
-		return config
+		return nil, fmt.Errorf("Error parsing the CLI config file %s: %v", path, err)
 	}
 
 	// Decode the CLI config file content.
 	if err := hcl.DecodeObject(&config, obj); err != nil {
-		log.Printf("[ERROR] Error decoding the CLI config file %s: %v", configFilePath, err)
+		return nil, fmt.Errorf("Error decoding the CLI config file %s: %v", path, err)
 	}
 
-	return config
+	return config, nil
+}
+
+// merge returns a new configuration with the settings of other layered on
+// top of the settings of c.
+func (c *Config) merge(other *Config) *Config {
+	result := &Config{
+		Hosts:              make(map[string]*ConfigHost),
+		Credentials:        make(map[string]map[string]interface{}),
+		CredentialsHelpers: make(map[string]*ConfigCredentialsHelper),
+	}
+
+	for _, config := range []*Config{c, other} {
+		for k, v := range config.Hosts {
+			result.Hosts[k] = v
+		}
+		for k, v := range config.Credentials {
+			result.Credentials[k] = v
+		}
+		for k, v := range config.CredentialsHelpers {
+			result.CredentialsHelpers[k] = v
+		}
+	}
+
+	return result
+}
+
+// tokenEnvName returns the name of the environment variable holding the
+// token for the given host. Dots in the hostname are replaced by underscores
+// and dashes by double underscores, e.g. TF_TOKEN_tfe_my__company_com.
+func tokenEnvName(host svchost.Hostname) string {
+	name := strings.Replace(host.String(), "-", "__", -1)
+	name = strings.Replace(name, ".", "_", -1)
+	return "TF_TOKEN_" + name
+}
+
+// tokenFromEnv returns the token for the given host from the TF_TOKEN_<host>
+// environment variables, or an empty string if none is set.
+func tokenFromEnv(host svchost.Hostname) string {
+	for _, env := range os.Environ() {
+		if !strings.HasPrefix(env, "TF_TOKEN_") {
+			continue
+		}
+
+		parts := strings.SplitN(env, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			continue
+		}
+
+		// Decode the hostname, so variables are matched in the same way the
+		// hostnames themselves are compared.
+		name := strings.TrimPrefix(parts[0], "TF_TOKEN_")
+		name = strings.Replace(name, "__", "-", -1)
+		name = strings.Replace(name, "_", ".", -1)
+
+		envHost, err := svchost.ForComparison(name)
+		if err != nil {
+			// Ignore variables that don't contain a valid hostname.
+			continue
+		}
+
+		if envHost == host {
+			return parts[1]
+		}
+	}
+
+	return ""
 }
 
 func credentialsSource(config *Config) auth.CredentialsSource {
-	creds := auth.NoCredentials
+	var sources auth.Credentials
 
 	// Add all configured credentials to the credentials source.
 	if len(config.Credentials) > 0 {
-		staticTable := map[svchost.Hostname]map[string]interface{}{}
-		for userHost, creds := range config.Credentials {
-			host, err := svchost.ForComparison(userHost)
-			if err != nil {
-				// We expect the config was already validated by the time we get
-				// here, so we'll just ignore invalid hostnames.
+		sources = append(sources, staticCredentialsSource(config.Credentials))
+	}
+
+	// Add the credentials saved by "terraform login" for any hosts without
+	// credentials in the CLI config file.
+	if creds := credentialsFile(); len(creds) > 0 {
+		sources = append(sources, staticCredentialsSource(creds))
+	}
+
+	// Add the credentials helper for any hosts without static credentials.
+	if helper := credentialsHelperSource(config); helper != nil {
+		sources = append(sources, helper)
+	}
+
+	if len(sources) == 0 {
+		return auth.NoCredentials
+	}
+
+	return sources
+}
+
+func staticCredentialsSource(creds map[string]map[string]interface{}) auth.CredentialsSource {
+	staticTable := map[svchost.Hostname]map[string]interface{}{}
+	for userHost, creds := range creds {
+		host, err := svchost.ForComparison(userHost)
+		if err != nil {
+			// We expect the config was already validated by the time we get
+			// here, so we'll just ignore invalid hostnames.
+			continue
+		}
+		staticTable[host] = creds
+	}
+	return auth.StaticCredentialsSource(staticTable)
+}
+
+// credentialsFile reads the credentials saved by "terraform login" in the
+// credentials.tfrc.json file in the CLI configuration directory. This is an
+// optional step, so any errors are ignored.
+func credentialsFile() map[string]map[string]interface{} {
+	dir, err := configDir()
+	if err != nil {
+		log.Printf("[ERROR] Error detecting default CLI config directory: %s", err)
+		return nil
+	}
+
+	path := filepath.Join(dir, "credentials.tfrc.json")
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[ERROR] Error reading the credentials file %s: %v", path, err)
+		}
+		return nil
+	}
+
+	var file struct {
+		Credentials map[string]map[string]interface{} `json:"credentials"`
+	}
+	if err := json.Unmarshal(content, &file); err != nil {
+		log.Printf("[ERROR] Error parsing the credentials file %s: %v", path, err)
+		return nil
+	}
+
+	return file.Credentials
+}
+
+// credentialsHelperSource returns a credentials source that runs the
+// configured credentials helper, or nil if no usable helper is configured.
+func credentialsHelperSource(config *Config) auth.CredentialsSource {
+	if len(config.CredentialsHelpers) == 0 {
+		return nil
+	}
+
+	// Just like Terraform, we only support a single credentials helper.
+	if len(config.CredentialsHelpers) > 1 {
+		log.Printf("[ERROR] Only one credentials_helper block is allowed, ignoring all of them")
+		return nil
+	}
+
+	for name, helper := range config.CredentialsHelpers {
+		executable, err := credentialsHelperPath(name)
+		if err != nil {
+			log.Printf("[ERROR] Error finding credentials helper %q: %v", name, err)
+			return nil
+		}
+
+		var args []string
+		if helper != nil {
+			args = helper.Args
+		}
+
+		log.Printf("[DEBUG] Using credentials helper: %s", executable)
+		return auth.CachingCredentialsSource(auth.HelperProgramCredentialsSource(executable, args...))
+	}
+
+	return nil
+}
+
+// credentialsHelperPath returns the absolute path of the executable of the
+// named credentials helper. Like Terraform, the helper is looked up in the
+// plugins directory within the CLI configuration directory.
+func credentialsHelperPath(name string) (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+
+	pluginDir := filepath.Join(dir, "plugins")
+	base := "terraform-credentials-" + name
+
+	for _, dir := range []string{
+		filepath.Join(pluginDir, runtime.GOOS+"_"+runtime.GOARCH),
+		pluginDir,
+	} {
+		matches, err := filepath.Glob(filepath.Join(dir, base+"*"))
+		if err != nil {
+			return "", err
+		}
+		for _, match := range matches {
+			// Allow a version or extension suffix, but not a different name.
+			suffix := strings.TrimPrefix(filepath.Base(match), base)
+			if suffix != "" && suffix[0] != '_' && suffix[0] != '.' {
 				continue
 			}
-			staticTable[host] = creds
+			if fi, err := os.Stat(match); err == nil && !fi.IsDir() {
+				return filepath.Abs(match)
+			}
 		}
-		creds = auth.StaticCredentialsSource(staticTable)
 	}
 
-	return creds
+	return "", fmt.Errorf("no terraform-credentials-%s executable found in %s", name, pluginDir)
 }
 
 var descriptions = map[string]string{
-	"hostname": "The Terraform Enterprise hostname to connect to. Defaults to app.terraform.io.",
-	"token": "The token used to authenticate with Terraform Enterprise. We recommend omitting\n" +
+	"hostname": "The Terraform Enterprise hostname to connect to. Can also be set with the\n" +
+		"TFE_HOSTNAME environment variable. Defaults to app.terraform.io.",
+	"address": "The address of the Terraform Enterprise API, e.g. https://tfe.example.com.\n" +
+		"Can also be set with the TFE_ADDRESS environment variable. When set, service\n" +
+		"discovery is skipped and the hostname is derived from this address.",
+	"base_path": "The base path on which the Terraform Enterprise API is served. Can also be\n" +
+		"set with the TFE_BASE_PATH environment variable. Defaults to /api/v2/.",
+	"token": "The token used to authenticate with Terraform Enterprise. Can also be set with\n" +
+		"the TFE_TOKEN or TF_TOKEN_<hostname> environment variables. We recommend omitting\n" +
 		"the token which can be set as credentials in the CLI config file.",
+	"admin_token": "The token used for the site-admin API, which is only used by resources\n" +
+		"that manage site-admin settings. Can also be set with the TFE_ADMIN_TOKEN\n" +
+		"environment variable.",
+	"organization": "The default organization of resources and data sources that do not set\n" +
+		"their own organization.",
+	"max_retries": "The maximum number of times a rate limited or failed request is retried.\n" +
+		"Defaults to 5.",
+	"retry_wait_min": "The minimum number of seconds to wait before retrying a request. Defaults to 1.",
+	"retry_wait_max": "The maximum number of seconds to wait before retrying a request, unless the\n" +
+		"API asks to wait longer. Defaults to 30.",
+	"requests_per_second": "The maximum number of requests per second sent by this provider. Set to 0\n" +
+		"to disable rate limiting. Defaults to 30.",
+	"requests_burst": "The maximum number of requests sent at once before the rate limit applies.\n" +
+		"Defaults to 30.",
+	"audit_log_path": "Path of a file to which a JSON line is appended for every API request that\n" +
+		"creates, updates or deletes an object. Can also be set with the TFE_AUDIT_LOG_PATH\n" +
+		"environment variable.",
+	"read_only": "Whether to refuse all API requests that create, update or delete objects.\n" +
+		"Can also be set with the TFE_READ_ONLY environment variable. Defaults to false.",
+	"ssl_skip_verify": "Whether to skip the verification of the server's TLS certificate.\n" +
+		"Defaults to false.",
+	"ca_cert_file":     "Path to a PEM encoded CA certificate used to verify the server.",
+	"ca_cert_pem":      "PEM encoded CA certificate used to verify the server.",
+	"client_cert_file": "Path to a PEM encoded client certificate used for TLS authentication.",
+	"client_cert_pem":  "PEM encoded client certificate used for TLS authentication.",
+	"client_key_file":  "Path to the PEM encoded private key of the client certificate.",
+	"client_key_pem":   "PEM encoded private key of the client certificate.",
+	"proxy_url": "URL of the proxy used to connect to Terraform Enterprise, including any\n" +
+		"credentials. Defaults to the proxy configured in the environment.",
+	"no_proxy": "Hosts, domains, IP addresses or CIDR ranges that are reached without using\n" +
+		"the proxy_url.",
 }
@@ -1,15 +1,27 @@
 package tfe
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/svchost"
+	"github.com/hashicorp/terraform/svchost/disco"
 	"github.com/hashicorp/terraform/terraform"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
+// ctx is used by the acceptance tests to check the objects in the API.
+var ctx = context.Background()
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
@@ -33,3 +45,441 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+func TestConfiguredClient_organization(t *testing.T) {
+	s := dataSourceTFEProject().Schema
+
+	cases := map[string]struct {
+		raw          map[string]interface{}
+		organization string
+		expected     string
+		err          bool
+	}{
+		"resource organization": {
+			raw:          map[string]interface{}{"organization": "resource-org"},
+			organization: "provider-org",
+			expected:     "resource-org",
+		},
+		"provider organization": {
+			raw:          map[string]interface{}{},
+			organization: "provider-org",
+			expected:     "provider-org",
+		},
+		"no organization": {
+			raw: map[string]interface{}{},
+			err: true,
+		},
+	}
+
+	for name, tc := range cases {
+		config := &ConfiguredClient{Organization: tc.organization}
+
+		organization, err := config.organization(schema.TestResourceDataRaw(t, s, tc.raw))
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error %t, got: %v", name, tc.err, err)
+		}
+		if organization != tc.expected {
+			t.Fatalf("%s: expected organization %q, got: %q", name, tc.expected, organization)
+		}
+	}
+}
+
+func TestCustomizeDiffOrganization(t *testing.T) {
+	r := resourceTFESSHKey()
+
+	state := &terraform.InstanceState{
+		ID: "sshkey-123",
+		Attributes: map[string]string{
+			"id":           "sshkey-123",
+			"name":         "ssh-key-test",
+			"organization": "old-org",
+			"key":          "SSH-KEY-CONTENT",
+		},
+	}
+
+	cases := map[string]struct {
+		raw          map[string]interface{}
+		organization string
+		requiresNew  bool
+		err          bool
+	}{
+		"unchanged default": {
+			raw:          map[string]interface{}{},
+			organization: "old-org",
+		},
+		"changed default": {
+			raw:          map[string]interface{}{},
+			organization: "new-org",
+			requiresNew:  true,
+		},
+		"configured organization": {
+			raw:          map[string]interface{}{"organization": "old-org"},
+			organization: "new-org",
+		},
+		"changed organization": {
+			raw:          map[string]interface{}{"organization": "other-org"},
+			organization: "new-org",
+			requiresNew:  true,
+		},
+		"no default": {
+			raw: map[string]interface{}{},
+		},
+	}
+
+	for name, tc := range cases {
+		tc.raw["name"] = "ssh-key-test"
+		tc.raw["key"] = "SSH-KEY-CONTENT"
+
+		raw, err := config.NewRawConfig(tc.raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		meta := &ConfiguredClient{Organization: tc.organization}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(raw), meta)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if !tc.requiresNew {
+			if diff != nil && !diff.Empty() {
+				t.Fatalf("%s: expected no diff, got: %#v", name, diff)
+			}
+			continue
+		}
+
+		attr, ok := diff.Attributes["organization"]
+		if !ok || !attr.RequiresNew {
+			t.Fatalf("%s: expected the organization to force a new resource, got: %#v", name, diff)
+		}
+	}
+
+	// A new resource without an organization gets the default organization.
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"name": "ssh-key-test",
+		"key":  "SSH-KEY-CONTENT",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	diff, err := r.Diff(nil, terraform.NewResourceConfig(raw), &ConfiguredClient{Organization: "new-org"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attr, ok := diff.Attributes["organization"]; !ok || attr.New != "new-org" {
+		t.Fatalf("expected the default organization, got: %#v", attr)
+	}
+
+	// Without a default organization the organization must be configured.
+	_, err = r.Diff(nil, terraform.NewResourceConfig(raw), &ConfiguredClient{})
+	if err != errMissingOrganization {
+		t.Fatalf("expected a missing organization error, got: %v", err)
+	}
+}
+
+func TestConfiguredClient_adminClient(t *testing.T) {
+	config := &ConfiguredClient{}
+	if _, err := config.adminClient(); err != errMissingAdminToken {
+		t.Fatalf("expected errMissingAdminToken, got: %v", err)
+	}
+
+	config.AdminClient = &tfe.Client{}
+	client, err := config.adminClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client != config.AdminClient {
+		t.Fatalf("expected the admin client to be returned")
+	}
+}
+
+func TestConfiguredClient_contextWithTimeout(t *testing.T) {
+	stopCtx, stop := context.WithCancel(context.Background())
+	config := &ConfiguredClient{StopContext: stopCtx}
+
+	d := schema.TestResourceDataRaw(t, resourceTFEWorkspace().Schema, map[string]interface{}{})
+
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutCreate)
+	defer cancel()
+
+	if _, ok := ctx.Deadline(); !ok {
+		t.Fatalf("expected the context to have a deadline")
+	}
+
+	// Stopping the provider should cancel all running operations.
+	stop()
+
+	select {
+	case <-ctx.Done():
+	default:
+		t.Fatalf("expected the context to be canceled when the provider is stopped")
+	}
+}
+
+func TestTokenFromEnv(t *testing.T) {
+	host, err := svchost.ForComparison("tfe.my-company.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	name := tokenEnvName(host)
+	if name != "TF_TOKEN_tfe_my__company_com" {
+		t.Fatalf("bad environment variable name: %s", name)
+	}
+
+	if token := tokenFromEnv(host); token != "" {
+		t.Fatalf("expected no token, got: %s", token)
+	}
+
+	os.Setenv(name, "secret")
+	defer os.Unsetenv(name)
+
+	if token := tokenFromEnv(host); token != "secret" {
+		t.Fatalf("expected token %q, got: %q", "secret", token)
+	}
+}
+
+func TestDiscoverServiceURL(t *testing.T) {
+	host, err := svchost.ForComparison("discovery.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	services := disco.New()
+	services.ForceHostServices(host, map[string]interface{}{
+		serviceID: "https://tfe.example.com/api/v2/",
+	})
+
+	address, err := discoverServiceURL(services, host)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if address.String() != "https://tfe.example.com/api/v2/" {
+		t.Fatalf("bad address: %s", address)
+	}
+
+	// A second lookup should use the cached result instead of discovering
+	// the services of the host again.
+	services = disco.New()
+	services.ForceHostServices(host, map[string]interface{}{
+		serviceID: "https://other.example.com/api/v2/",
+	})
+
+	address, err = discoverServiceURL(services, host)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if address.String() != "https://tfe.example.com/api/v2/" {
+		t.Fatalf("expected cached address, got: %s", address)
+	}
+}
+
+func TestCredentialsSource_helper(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test credentials helper is a shell script")
+	}
+
+	home, err := ioutil.TempDir("", "home")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(home)
+
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", oldHome)
+
+	pluginDir := filepath.Join(home, ".terraform.d", "plugins")
+	if err := os.MkdirAll(pluginDir, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The helper returns the token passed as its first argument.
+	helper := `#!/bin/sh
+echo "{\"token\":\"$1\"}"
+`
+	err = ioutil.WriteFile(filepath.Join(pluginDir, "terraform-credentials-test"), []byte(helper), 0755)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := &Config{
+		Credentials: map[string]map[string]interface{}{
+			"static.example.com": {"token": "static-token"},
+		},
+		CredentialsHelpers: map[string]*ConfigCredentialsHelper{
+			"test": {Args: []string{"helper-token"}},
+		},
+	}
+	credsSrc := credentialsSource(config)
+
+	cases := map[string]string{
+		"static.example.com": "static-token",
+		"helper.example.com": "helper-token",
+	}
+
+	for hostname, expected := range cases {
+		creds, err := credsSrc.ForHost(svchost.Hostname(hostname))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", hostname, err)
+		}
+		if creds == nil || creds.Token() != expected {
+			t.Fatalf("%s: expected token %q, got: %v", hostname, expected, creds)
+		}
+	}
+}
+
+func TestCredentialsSource_credentialsFile(t *testing.T) {
+	home, err := ioutil.TempDir("", "home")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(home)
+
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", oldHome)
+
+	dir, err := configDir()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content := `{
+  "credentials": {
+    "app.terraform.io": {"token": "login-token"},
+    "tfe.example.com": {"token": "login-token"}
+  }
+}`
+	err = ioutil.WriteFile(filepath.Join(dir, "credentials.tfrc.json"), []byte(content), 0600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Credentials in the CLI config file take precedence.
+	config := &Config{
+		Credentials: map[string]map[string]interface{}{
+			"tfe.example.com": {"token": "config-token"},
+		},
+	}
+	credsSrc := credentialsSource(config)
+
+	cases := map[string]string{
+		"app.terraform.io": "login-token",
+		"tfe.example.com":  "config-token",
+	}
+
+	for hostname, expected := range cases {
+		creds, err := credsSrc.ForHost(svchost.Hostname(hostname))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", hostname, err)
+		}
+		if creds == nil || creds.Token() != expected {
+			t.Fatalf("%s: expected token %q, got: %v", hostname, expected, creds)
+		}
+	}
+}
+
+func TestCLIConfig(t *testing.T) {
+	home, err := ioutil.TempDir("", "home")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(home)
+
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", oldHome)
+
+	mainFile, err := configFile()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	dir, err := configDir()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	files := map[string]string{
+		mainFile: `
+credentials "app.terraform.io" {
+  token = "main-token"
+}
+
+credentials "tfe.example.com" {
+  token = "main-token"
+}`,
+		filepath.Join(dir, "tfe.tfrc"): `
+credentials "tfe.example.com" {
+  token = "dir-token"
+}
+
+host "tfe.example.com" {
+  services = {
+    "tfe.v2" = "https://tfe.example.com/api/v2/"
+  }
+}`,
+		filepath.Join(dir, "ignored.json"): `credentials "ignored.example.com" { token = "x" }`,
+		filepath.Join(home, "custom.tfrc"): `
+credentials "custom.example.com" {
+  token = "custom-token"
+}`,
+	}
+	for path, content := range files {
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	config := cliConfig()
+
+	if token := config.Credentials["app.terraform.io"]["token"]; token != "main-token" {
+		t.Fatalf("expected the main config file to be loaded, got token: %v", token)
+	}
+	if token := config.Credentials["tfe.example.com"]["token"]; token != "dir-token" {
+		t.Fatalf("expected *.tfrc files to override the main config file, got token: %v", token)
+	}
+	if _, ok := config.Hosts["tfe.example.com"]; !ok {
+		t.Fatalf("expected the host from the *.tfrc file to be loaded")
+	}
+	if _, ok := config.Credentials["ignored.example.com"]; ok {
+		t.Fatalf("expected files without a .tfrc extension to be ignored")
+	}
+
+	// TF_CLI_CONFIG_FILE replaces the main config file.
+	os.Setenv("TF_CLI_CONFIG_FILE", filepath.Join(home, "custom.tfrc"))
+	defer os.Unsetenv("TF_CLI_CONFIG_FILE")
+
+	config = cliConfig()
+
+	if _, ok := config.Credentials["app.terraform.io"]; ok {
+		t.Fatalf("expected the main config file to be replaced by TF_CLI_CONFIG_FILE")
+	}
+	if token := config.Credentials["custom.example.com"]["token"]; token != "custom-token" {
+		t.Fatalf("expected TF_CLI_CONFIG_FILE to be loaded, got token: %v", token)
+	}
+	if _, ok := config.Credentials["tfe.example.com"]; ok {
+		t.Fatalf("expected *.tfrc files to be ignored when TF_CLI_CONFIG_FILE is set")
+	}
+	os.Unsetenv("TF_CLI_CONFIG_FILE")
+
+	// TERRAFORM_CONFIG is the deprecated name of TF_CLI_CONFIG_FILE.
+	os.Setenv("TERRAFORM_CONFIG", filepath.Join(home, "custom.tfrc"))
+	defer os.Unsetenv("TERRAFORM_CONFIG")
+
+	config = cliConfig()
+
+	if token := config.Credentials["custom.example.com"]["token"]; token != "custom-token" {
+		t.Fatalf("expected TERRAFORM_CONFIG to be loaded, got token: %v", token)
+	}
+	if _, ok := config.Credentials["tfe.example.com"]; ok {
+		t.Fatalf("expected *.tfrc files to be ignored when TERRAFORM_CONFIG is set")
+	}
+}
@@ -0,0 +1,76 @@
+package tfe
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// proxyFunc returns the function used by the transport to select a proxy for
+// each request. Without an explicit proxy URL, the proxy configured in the
+// environment (HTTPS_PROXY, HTTP_PROXY and NO_PROXY) is used.
+func proxyFunc(proxyURL string, noProxy []string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxy, err := url.Parse(proxyURL)
+	if err != nil || proxy.Host == "" {
+		return nil, fmt.Errorf(
+			"Invalid proxy_url %q: a URL like http://proxy.company.com:3128 is required", redactedProxyURL(proxyURL))
+	}
+
+	return func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(req.URL.Hostname(), noProxy) {
+			return nil, nil
+		}
+		return proxy, nil
+	}, nil
+}
+
+// redactedProxyURL returns the proxy URL to log or return in an error, with
+// any credentials redacted. It doesn't need a valid URL, as invalid URLs are
+// reported as well.
+func redactedProxyURL(proxyURL string) string {
+	at := strings.LastIndex(proxyURL, "@")
+	if at < 0 {
+		return proxyURL
+	}
+
+	var scheme string
+	if i := strings.Index(proxyURL, "://"); i >= 0 && i < at {
+		scheme = proxyURL[:i+3]
+	}
+
+	return scheme + redacted + proxyURL[at:]
+}
+
+// bypassProxy returns whether a host matches any of the no-proxy entries.
+// Entries can be hostnames, which also match any subdomains, IP addresses,
+// CIDR ranges or "*" to match all hosts.
+func bypassProxy(host string, noProxy []string) bool {
+	host = strings.ToLower(host)
+
+	for _, entry := range noProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+
+		switch {
+		case entry == "":
+			continue
+		case entry == "*", entry == host:
+			return true
+		case strings.HasSuffix(host, "."+strings.TrimPrefix(entry, ".")):
+			return true
+		}
+
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip := net.ParseIP(host); ip != nil && cidr.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceTFEAdminOrganizationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEAdminOrganizationSettingsCreate,
+		Read:   resourceTFEAdminOrganizationSettingsRead,
+		Update: resourceTFEAdminOrganizationSettingsUpdate,
+		Delete: resourceTFEAdminOrganizationSettingsDelete,
+
+		CustomizeDiff: resourceTFEAdminOrganizationSettingsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"access_beta_tools": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"global_module_sharing": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"workspace_limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceTFEAdminOrganizationSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	// The settings of an organization always exist, so we only need to set
+	// the ID and update the settings.
+	d.SetId(d.Get("organization").(string))
+
+	return resourceTFEAdminOrganizationSettingsUpdate(d, meta)
+}
+
+func resourceTFEAdminOrganizationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient, err := config.adminClient()
+	if err != nil {
+		return err
+	}
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Read admin settings of organization: %s", d.Id())
+	org, err := tfeClient.Admin.Organizations.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Organization %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading admin settings of organization %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("organization", org.Name)
+	d.Set("access_beta_tools", org.AccessBetaTools)
+	if org.GlobalModuleSharing != nil {
+		d.Set("global_module_sharing", *org.GlobalModuleSharing)
+	}
+	if org.WorkspaceLimit != nil {
+		d.Set("workspace_limit", *org.WorkspaceLimit)
+	}
+
+	return nil
+}
+
+func resourceTFEAdminOrganizationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient, err := config.adminClient()
+	if err != nil {
+		return err
+	}
+	ctx := config.StopContext
+
+	// Create a new options struct.
+	options := tfe.AdminOrganizationUpdateOptions{
+		AccessBetaTools:     tfe.Bool(d.Get("access_beta_tools").(bool)),
+		GlobalModuleSharing: tfe.Bool(d.Get("global_module_sharing").(bool)),
+	}
+
+	// If workspace_limit is supplied, set it using the options struct.
+	if workspaceLimit, ok := d.GetOkExists("workspace_limit"); ok {
+		options.WorkspaceLimit = tfe.Int(workspaceLimit.(int))
+	}
+
+	log.Printf("[DEBUG] Update admin settings of organization: %s", d.Id())
+	_, err = tfeClient.Admin.Organizations.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating admin settings of organization %s: %v", d.Id(), err)
+	}
+
+	return resourceTFEAdminOrganizationSettingsRead(d, meta)
+}
+
+func resourceTFEAdminOrganizationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// The settings can't be deleted, so they are only removed from the state
+	// and keep their current values.
+	log.Printf("[DEBUG] Remove admin settings of organization %s from the state", d.Id())
+	return nil
+}
+
+func resourceTFEAdminOrganizationSettingsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffAdminToken(d, meta); err != nil {
+		return err
+	}
+	return customizeDiffOrganization(d, meta)
+}
@@ -0,0 +1,97 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFEAdminOrganizationSettings_basic(t *testing.T) {
+	org := &tfe.AdminOrganization{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckAdmin(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEAdminOrganizationSettings_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEAdminOrganizationSettingsExists(
+						"tfe_admin_organization_settings.foobar", org),
+					testAccCheckTFEAdminOrganizationSettingsAttributes(org),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.foobar", "organization", "terraform-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.foobar", "global_module_sharing", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_admin_organization_settings.foobar", "workspace_limit", "15"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckAdmin skips the test when no admin token is configured, as
+// the site-admin API is only available to site admins.
+func testAccPreCheckAdmin(t *testing.T) {
+	if os.Getenv("TFE_ADMIN_TOKEN") == "" {
+		t.Skip("Please set TFE_ADMIN_TOKEN to run this test")
+	}
+	testAccPreCheck(t)
+}
+
+func testAccCheckTFEAdminOrganizationSettingsExists(
+	n string, org *tfe.AdminOrganization) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).AdminClient
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		o, err := tfeClient.Admin.Organizations.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*org = *o
+
+		return nil
+	}
+}
+
+func testAccCheckTFEAdminOrganizationSettingsAttributes(
+	org *tfe.AdminOrganization) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if org.GlobalModuleSharing == nil || !*org.GlobalModuleSharing {
+			return fmt.Errorf("Bad global module sharing: %v", org.GlobalModuleSharing)
+		}
+
+		if org.WorkspaceLimit == nil || *org.WorkspaceLimit != 15 {
+			return fmt.Errorf("Bad workspace limit: %v", org.WorkspaceLimit)
+		}
+
+		return nil
+	}
+}
+
+const testAccTFEAdminOrganizationSettings_basic = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_admin_organization_settings" "foobar" {
+  organization = "${tfe_organization.foobar.id}"
+  global_module_sharing = true
+  workspace_limit = 15
+}`
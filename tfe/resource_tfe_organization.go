@@ -56,7 +56,9 @@ func resourceTFEOrganization() *schema.Resource {
 }
 
 func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 	name := d.Get("name").(string)
@@ -79,7 +81,9 @@ func resourceTFEOrganizationCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read configuration of organization: %s", d.Id())
 	org, err := tfeClient.Organizations.Read(ctx, d.Id())
@@ -103,7 +107,9 @@ func resourceTFEOrganizationRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Create a new options struct.
 	options := tfe.OrganizationUpdateOptions{
@@ -138,7 +144,9 @@ func resourceTFEOrganizationUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceTFEOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete organization: %s", d.Id())
 	err := tfeClient.Organizations.Delete(ctx, d.Id())
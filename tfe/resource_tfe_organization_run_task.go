@@ -0,0 +1,169 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTFEOrganizationRunTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEOrganizationRunTaskCreate,
+		Read:   resourceTFEOrganizationRunTaskRead,
+		Update: resourceTFEOrganizationRunTaskUpdate,
+		Delete: resourceTFEOrganizationRunTaskDelete,
+
+		CustomizeDiff: customizeDiffOrganization,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"category": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "task",
+			},
+
+			"hmac_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				Default:   "",
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceTFEOrganizationRunTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+
+	// Create a new options struct.
+	options := tfe.RunTaskCreateOptions{
+		Name:        name,
+		URL:         d.Get("url").(string),
+		Description: tfe.String(d.Get("description").(string)),
+		Category:    d.Get("category").(string),
+		HMACKey:     tfe.String(d.Get("hmac_key").(string)),
+		Enabled:     tfe.Bool(d.Get("enabled").(bool)),
+	}
+
+	log.Printf("[DEBUG] Create run task %s for organization: %s", name, organization)
+	task, err := tfeClient.RunTasks.Create(ctx, organization, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating run task %s for organization %s: %v", name, organization, err)
+	}
+
+	d.SetId(task.ID)
+
+	return resourceTFEOrganizationRunTaskRead(d, meta)
+}
+
+func resourceTFEOrganizationRunTaskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Read configuration of run task: %s", d.Id())
+	task, err := tfeClient.RunTasks.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Run task %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of run task %s: %v", d.Id(), err)
+	}
+
+	// Update the config. The HMAC key is write-only, so it is never
+	// returned by the API and is kept as configured.
+	d.Set("name", task.Name)
+	d.Set("url", task.URL)
+	d.Set("description", task.Description)
+	d.Set("category", task.Category)
+	d.Set("enabled", task.Enabled)
+	if task.Organization != nil {
+		d.Set("organization", task.Organization.Name)
+	}
+
+	return nil
+}
+
+func resourceTFEOrganizationRunTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Create a new options struct.
+	options := tfe.RunTaskUpdateOptions{
+		Name:        tfe.String(d.Get("name").(string)),
+		URL:         tfe.String(d.Get("url").(string)),
+		Description: tfe.String(d.Get("description").(string)),
+		Category:    tfe.String(d.Get("category").(string)),
+		Enabled:     tfe.Bool(d.Get("enabled").(bool)),
+	}
+
+	// Only send the HMAC key when it changed, so it isn't reset by accident.
+	if d.HasChange("hmac_key") {
+		options.HMACKey = tfe.String(d.Get("hmac_key").(string))
+	}
+
+	log.Printf("[DEBUG] Update run task: %s", d.Id())
+	_, err := tfeClient.RunTasks.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating run task %s: %v", d.Id(), err)
+	}
+
+	return resourceTFEOrganizationRunTaskRead(d, meta)
+}
+
+func resourceTFEOrganizationRunTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Delete run task: %s", d.Id())
+	err := tfeClient.RunTasks.Delete(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting run task %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFEOrganizationRunTask_basic(t *testing.T) {
+	task := &tfe.RunTask{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckRunTask(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOrganizationRunTaskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEOrganizationRunTask_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationRunTaskExists(
+						"tfe_organization_run_task.foobar", task),
+					testAccCheckTFEOrganizationRunTaskAttributes(task, "run-task-test", true),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_run_task.foobar", "name", "run-task-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_run_task.foobar", "category", "task"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_run_task.foobar", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEOrganizationRunTask_update(t *testing.T) {
+	task := &tfe.RunTask{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckRunTask(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOrganizationRunTaskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEOrganizationRunTask_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationRunTaskExists(
+						"tfe_organization_run_task.foobar", task),
+					testAccCheckTFEOrganizationRunTaskAttributes(task, "run-task-test", true),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEOrganizationRunTask_update(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationRunTaskExists(
+						"tfe_organization_run_task.foobar", task),
+					testAccCheckTFEOrganizationRunTaskAttributes(task, "run-task-updated", false),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_run_task.foobar", "name", "run-task-updated"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_run_task.foobar", "description", "Scans every plan"),
+					resource.TestCheckResourceAttr(
+						"tfe_organization_run_task.foobar", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckRunTask skips the test when no run task endpoint is
+// configured, as creating a run task requires a reachable URL.
+func testAccPreCheckRunTask(t *testing.T) {
+	if os.Getenv("TFE_RUN_TASK_URL") == "" {
+		t.Skip("Please set TFE_RUN_TASK_URL to run this test")
+	}
+	testAccPreCheck(t)
+}
+
+func testAccCheckTFEOrganizationRunTaskExists(
+	n string, task *tfe.RunTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		rt, err := tfeClient.RunTasks.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if rt.ID != rs.Primary.ID {
+			return fmt.Errorf("Run task not found")
+		}
+
+		*task = *rt
+
+		return nil
+	}
+}
+
+func testAccCheckTFEOrganizationRunTaskAttributes(
+	task *tfe.RunTask, name string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if task.Name != name {
+			return fmt.Errorf("Bad name: %s", task.Name)
+		}
+
+		if task.Enabled != enabled {
+			return fmt.Errorf("Bad enabled: %t", task.Enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEOrganizationRunTaskDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_organization_run_task" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.RunTasks.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Run task %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEOrganizationRunTask_basic() string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization_run_task" "foobar" {
+  name = "run-task-test"
+  organization = "${tfe_organization.foobar.id}"
+  url = "%s"
+}`, os.Getenv("TFE_RUN_TASK_URL"))
+}
+
+func testAccTFEOrganizationRunTask_update() string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization_run_task" "foobar" {
+  name = "run-task-updated"
+  organization = "${tfe_organization.foobar.id}"
+  url = "%s"
+  description = "Scans every plan"
+  hmac_key = "secret"
+  enabled = false
+}`, os.Getenv("TFE_RUN_TASK_URL"))
+}
@@ -3,6 +3,7 @@ package tfe
 import (
 	"fmt"
 	"log"
+	"time"
 
 	tfe "github.com/HappyPathway/go-tfe"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,12 +13,16 @@ func resourceTFEOrganizationToken() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTFEOrganizationTokenCreate,
 		Read:   resourceTFEOrganizationTokenRead,
+		Update: resourceTFEOrganizationTokenUpdate,
 		Delete: resourceTFEOrganizationTokenDelete,
 
+		CustomizeDiff: resourceTFEOrganizationTokenCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"organization": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -32,12 +37,37 @@ func resourceTFEOrganizationToken() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"rotate_after": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRotateAfter,
+			},
+
+			"keepers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"token_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 	organization := d.Get("organization").(string)
@@ -68,14 +98,21 @@ func resourceTFEOrganizationTokenCreate(d *schema.ResourceData, meta interface{}
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
+	// Keep track of the generated token, so we can detect when the token is
+	// regenerated outside of Terraform.
+	d.Set("token_id", token.ID)
+	d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+
 	return resourceTFEOrganizationTokenRead(d, meta)
 }
 
 func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read the token from organization: %s", d.Id())
-	_, err := tfeClient.OrganizationTokens.Read(ctx, d.Id())
+	token, err := tfeClient.OrganizationTokens.Read(ctx, d.Id())
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			log.Printf("[DEBUG] Token for organization %s does no longer exist", d.Id())
@@ -85,11 +122,56 @@ func resourceTFEOrganizationTokenRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error reading token from organization %s: %v", d.Id(), err)
 	}
 
+	// Only fill in the token details for tokens created by an older version
+	// of this provider. Otherwise they are kept as generated, so a token that
+	// is regenerated outside of Terraform is detected when planning.
+	if d.Get("token_id").(string) == "" {
+		d.Set("token_id", token.ID)
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+
 	return nil
 }
 
+func resourceTFEOrganizationTokenCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	if err := customizeDiffOrganization(d, meta); err != nil {
+		return err
+	}
+
+	// Nothing to compare against when the token is not created yet.
+	if d.Id() == "" {
+		return nil
+	}
+
+	if err := customizeTokenRotationDiff(d, meta); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read the token from organization: %s", d.Id())
+	token, err := tfeClient.OrganizationTokens.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error reading token from organization %s: %v", d.Id(), err)
+	}
+
+	return customizeTokenRegenerationDiff(d, token.ID)
+}
+
+func resourceTFEOrganizationTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only the rotation window can be updated, which is not stored remotely.
+	return resourceTFEOrganizationTokenRead(d, meta)
+}
+
 func resourceTFEOrganizationTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 	organization := d.Get("organization").(string)
@@ -88,10 +88,52 @@ func TestAccTFEOrganizationToken_existsWithForce(t *testing.T) {
 	})
 }
 
+func TestAccTFEOrganizationToken_keepers(t *testing.T) {
+	token := &tfe.OrganizationToken{}
+	var tokenID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEOrganizationTokenDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEOrganizationToken_keepers("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationTokenExists(
+						"tfe_organization_token.foobar", token),
+					func(s *terraform.State) error {
+						tokenID = token.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"tfe_organization_token.foobar", "rotate_after", "90d"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_organization_token.foobar", "created_at"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEOrganizationToken_keepers("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEOrganizationTokenExists(
+						"tfe_organization_token.foobar", token),
+					func(s *terraform.State) error {
+						if token.ID == tokenID {
+							return fmt.Errorf("Token was not regenerated after changing the keepers")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTFEOrganizationTokenExists(
 	n string, token *tfe.OrganizationToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -118,7 +160,7 @@ func testAccCheckTFEOrganizationTokenExists(
 }
 
 func testAccCheckTFEOrganizationTokenDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_organization_token" {
@@ -176,3 +218,20 @@ resource "tfe_organization_token" "regenerated" {
   organization = "${tfe_organization.foobar.id}"
   force_regenerate = true
 }`
+
+func testAccTFEOrganizationToken_keepers(rotation string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_organization_token" "foobar" {
+  organization = "${tfe_organization.foobar.id}"
+  rotate_after = "90d"
+
+  keepers = {
+    rotation = "%s"
+  }
+}`, rotation)
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"log"
 
-	tfe "github.com/hashicorp/go-tfe"
+	tfe "github.com/HappyPathway/go-tfe"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -55,7 +55,9 @@ func resourceTFEOrganizationVCS() *schema.Resource {
 }
 
 func resourceTFEOrganizationVCSCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 	organization := d.Get("organization").(string)
@@ -97,26 +99,25 @@ func resourceTFEOrganizationVCSUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceTFEOrganizationVCSDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 
 	organization := d.Get("organization").(string)
 	vcs_id := d.Get("vcs_id").(string)
 
-	options := tfe.OAuthCLientDestroyOptions{
-		CLIENT_ID: tfe.String(vcs_id),
-	}
-
-	// Create a new options struct.
 	log.Printf("[DEBUG] Delete VCS Connection for Org: %s", organization)
 	log.Printf("[DEBUG] Deleting VCS Connection: %s", vcs_id)
 
-	vcs, err := tfeClient.OAuthClients.Delete(ctx, options)
+	err := tfeClient.OAuthClients.Delete(ctx, vcs_id)
 	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
 		return fmt.Errorf("Error deleting the vcs id %s: %v", vcs_id, err)
 	}
-	d.SetId(vcs.ID)
 
 	return nil
 }
@@ -0,0 +1,121 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTFEProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEProjectCreate,
+		Read:   resourceTFEProjectRead,
+		Update: resourceTFEProjectUpdate,
+		Delete: resourceTFEProjectDelete,
+
+		CustomizeDiff: customizeDiffOrganization,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFEProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the name and organization.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+
+	// Create a new options struct.
+	options := tfe.ProjectCreateOptions{
+		Name: tfe.String(name),
+	}
+
+	log.Printf("[DEBUG] Create project %s for organization: %s", name, organization)
+	project, err := tfeClient.Projects.Create(ctx, organization, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating project %s for organization %s: %v", name, organization, err)
+	}
+
+	d.SetId(project.ID)
+
+	return resourceTFEProjectRead(d, meta)
+}
+
+func resourceTFEProjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Read configuration of project: %s", d.Id())
+	project, err := tfeClient.Projects.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Project %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of project %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("name", project.Name)
+	if project.Organization != nil {
+		d.Set("organization", project.Organization.Name)
+	}
+
+	return nil
+}
+
+func resourceTFEProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Create a new options struct.
+	options := tfe.ProjectUpdateOptions{
+		Name: tfe.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Update project: %s", d.Id())
+	_, err := tfeClient.Projects.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating project %s: %v", d.Id(), err)
+	}
+
+	return resourceTFEProjectRead(d, meta)
+}
+
+func resourceTFEProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Delete project: %s", d.Id())
+	err := tfeClient.Projects.Delete(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting project %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
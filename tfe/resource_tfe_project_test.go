@@ -0,0 +1,147 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFEProject_basic(t *testing.T) {
+	project := &tfe.Project{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEProject_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEProjectExists(
+						"tfe_project.foobar", project),
+					testAccCheckTFEProjectAttributes(project, "project-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "name", "project-test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEProject_update(t *testing.T) {
+	project := &tfe.Project{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEProject_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEProjectExists(
+						"tfe_project.foobar", project),
+					testAccCheckTFEProjectAttributes(project, "project-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "name", "project-test"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEProject_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEProjectExists(
+						"tfe_project.foobar", project),
+					testAccCheckTFEProjectAttributes(project, "project-updated"),
+					resource.TestCheckResourceAttr(
+						"tfe_project.foobar", "name", "project-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEProjectExists(
+	n string, project *tfe.Project) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		p, err := tfeClient.Projects.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if p.ID != rs.Primary.ID {
+			return fmt.Errorf("Project not found")
+		}
+
+		*project = *p
+
+		return nil
+	}
+}
+
+func testAccCheckTFEProjectAttributes(
+	project *tfe.Project, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if project.Name != name {
+			return fmt.Errorf("Bad name: %s", project.Name)
+		}
+		return nil
+	}
+}
+
+func testAccCheckTFEProjectDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_project" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.Projects.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Project %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccTFEProject_basic = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name = "project-test"
+  organization = "${tfe_organization.foobar.id}"
+}`
+
+const testAccTFEProject_update = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "foobar" {
+  name = "project-updated"
+  organization = "${tfe_organization.foobar.id}"
+}`
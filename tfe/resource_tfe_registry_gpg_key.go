@@ -0,0 +1,117 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTFERegistryGPGKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFERegistryGPGKeyCreate,
+		Read:   resourceTFERegistryGPGKeyRead,
+		Delete: resourceTFERegistryGPGKeyDelete,
+
+		CustomizeDiff: customizeDiffOrganization,
+
+		Schema: map[string]*schema.Schema{
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"ascii_armor": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFERegistryGPGKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the organization name.
+	organization := d.Get("organization").(string)
+
+	// Create a new options struct.
+	options := tfe.GPGKeyCreateOptions{
+		Namespace:  organization,
+		AsciiArmor: d.Get("ascii_armor").(string),
+	}
+
+	log.Printf("[DEBUG] Create GPG key for organization: %s", organization)
+	key, err := tfeClient.GPGKeys.Create(ctx, tfe.PrivateRegistry, options)
+	if err != nil {
+		return fmt.Errorf("Error creating GPG key for organization %s: %v", organization, err)
+	}
+
+	d.SetId(key.KeyID)
+
+	return resourceTFERegistryGPGKeyRead(d, meta)
+}
+
+func resourceTFERegistryGPGKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Read GPG key: %s", d.Id())
+	key, err := tfeClient.GPGKeys.Read(ctx, registryGPGKeyID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] GPG key %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading GPG key %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("organization", key.Namespace)
+	d.Set("ascii_armor", key.AsciiArmor)
+	d.Set("key_id", key.KeyID)
+
+	return nil
+}
+
+func resourceTFERegistryGPGKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Delete GPG key: %s", d.Id())
+	err := tfeClient.GPGKeys.Delete(ctx, registryGPGKeyID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting GPG key %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func registryGPGKeyID(d *schema.ResourceData) tfe.GPGKeyID {
+	return tfe.GPGKeyID{
+		RegistryName: tfe.PrivateRegistry,
+		Namespace:    d.Get("organization").(string),
+		KeyID:        d.Id(),
+	}
+}
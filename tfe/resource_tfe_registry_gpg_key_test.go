@@ -0,0 +1,112 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFERegistryGPGKey_basic(t *testing.T) {
+	key := &tfe.GPGKey{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryGPGKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFERegistryGPGKey_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryGPGKeyExists(
+						"tfe_registry_gpg_key.foobar", key),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_gpg_key.foobar", "organization", "terraform-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_gpg_key.foobar", "key_id", "6594EF01AD8606E1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFERegistryGPGKeyExists(
+	n string, key *tfe.GPGKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		k, err := tfeClient.GPGKeys.Read(ctx, tfe.GPGKeyID{
+			RegistryName: tfe.PrivateRegistry,
+			Namespace:    rs.Primary.Attributes["organization"],
+			KeyID:        rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if k.KeyID != rs.Primary.ID {
+			return fmt.Errorf("GPG key not found")
+		}
+
+		*key = *k
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryGPGKeyDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_registry_gpg_key" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.GPGKeys.Read(ctx, tfe.GPGKeyID{
+			RegistryName: tfe.PrivateRegistry,
+			Namespace:    rs.Primary.Attributes["organization"],
+			KeyID:        rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("GPG key %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccTFERegistryGPGKey_basic = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_gpg_key" "foobar" {
+  organization = "${tfe_organization.foobar.id}"
+  ascii_armor = <<EOF
+-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatY7YBYJKwYBBAHaRw8BAQdAdT7rLKP/62YkjdEGFubGlofUn2WIpNy4UFYM
+XFKdo7q0IVRlcnJhZm9ybSBUZXN0IDx0ZXN0QGV4YW1wbGUuY29tPoiQBBMWCAA4
+FiEEgsdy2yoNsQ88cFtaZZTvAa2GBuEFAmrWO2ACGwMFCwkIBwIGFQoJCAsCBBYC
+AwECHgECF4AACgkQZZTvAa2GBuEMRgEAs8GdIGoBYOmF2q3520nkY2ErJLdCR6xX
+9MNca/C9zs8A+wTEQjUBpqea+azZDVQiO6EGU0J/m69/D/4YTH/jURgH
+=0+nd
+-----END PGP PUBLIC KEY BLOCK-----
+EOF
+}`
@@ -15,10 +15,13 @@ func resourceTFERegistryModule() *schema.Resource {
 		Update: resourceTFERegistryModuleUpdate,
 		Delete: resourceTFERegistryModuleDelete,
 
+		CustomizeDiff: customizeDiffOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"organization": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"oauth_token": &schema.Schema{
 				Type:     schema.TypeString,
@@ -53,7 +56,9 @@ func resourceTFERegistryModule() *schema.Resource {
 }
 
 func resourceTFERegistryModuleCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 	oauth_token := d.Get("oauth_token").(string)
@@ -86,7 +91,9 @@ func resourceTFERegistryModuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTFERegistryModuleDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the organization name.
 
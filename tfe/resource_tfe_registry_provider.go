@@ -0,0 +1,188 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceTFERegistryProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFERegistryProviderCreate,
+		Read:   resourceTFERegistryProviderRead,
+		Delete: resourceTFERegistryProviderDelete,
+
+		CustomizeDiff: resourceTFERegistryProviderCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"registry_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(tfe.PrivateRegistry),
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.PrivateRegistry),
+						string(tfe.PublicRegistry),
+					},
+					false,
+				),
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFERegistryProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the name, organization and registry name.
+	name := d.Get("name").(string)
+	organization := d.Get("organization").(string)
+	registryName := tfe.RegistryName(d.Get("registry_name").(string))
+
+	// Providers in the private registry always use the organization name
+	// as their namespace.
+	namespace := organization
+	if registryName == tfe.PublicRegistry {
+		ns, ok := d.GetOk("namespace")
+		if !ok {
+			return fmt.Errorf("namespace is required for providers in the public registry")
+		}
+		namespace = ns.(string)
+	}
+
+	// Create a new options struct.
+	options := tfe.RegistryProviderCreateOptions{
+		Name:         name,
+		Namespace:    namespace,
+		RegistryName: registryName,
+	}
+
+	log.Printf("[DEBUG] Create registry provider %s/%s for organization: %s", namespace, name, organization)
+	provider, err := tfeClient.RegistryProviders.Create(ctx, organization, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating registry provider %s/%s for organization %s: %v", namespace, name, organization, err)
+	}
+
+	d.SetId(provider.ID)
+	d.Set("namespace", provider.Namespace)
+
+	return resourceTFERegistryProviderRead(d, meta)
+}
+
+func resourceTFERegistryProviderRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Read configuration of registry provider: %s", d.Id())
+	provider, err := tfeClient.RegistryProviders.Read(ctx, registryProviderID(d), nil)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Registry provider %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of registry provider %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("name", provider.Name)
+	d.Set("namespace", provider.Namespace)
+	d.Set("registry_name", string(provider.RegistryName))
+	d.Set("created_at", provider.CreatedAt)
+
+	return nil
+}
+
+func resourceTFERegistryProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Delete registry provider: %s", d.Id())
+	err := tfeClient.RegistryProviders.Delete(ctx, registryProviderID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting registry provider %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// registryProviderID returns the composite ID the registry API uses to
+// address a provider, as the API has no endpoints to read a provider by
+// its external ID.
+func registryProviderID(d *schema.ResourceData) tfe.RegistryProviderID {
+	organization := d.Get("organization").(string)
+	registryName := tfe.RegistryName(d.Get("registry_name").(string))
+
+	namespace := d.Get("namespace").(string)
+	if namespace == "" {
+		namespace = organization
+	}
+
+	return tfe.RegistryProviderID{
+		OrganizationName: organization,
+		RegistryName:     registryName,
+		Namespace:        namespace,
+		Name:             d.Get("name").(string),
+	}
+}
+
+func resourceTFERegistryProviderCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffOrganization(d, meta); err != nil {
+		return err
+	}
+
+	// Only check a namespace that is configured, as the namespace is read
+	// back from the API, and unknown values will be known when applying.
+	if !d.HasChange("namespace") || !d.NewValueKnown("namespace") || !d.NewValueKnown("organization") {
+		return nil
+	}
+
+	// Providers in the private registry always use the organization name
+	// as their namespace, so any other namespace would never converge.
+	namespace := d.Get("namespace").(string)
+	organization := d.Get("organization").(string)
+	if d.Get("registry_name").(string) != string(tfe.PublicRegistry) && namespace != organization {
+		return fmt.Errorf(
+			"namespace can only be set for providers in the public registry, providers in the "+
+				"private registry use the organization name %q as namespace", organization)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package tfe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTFERegistryProviderPlatform() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFERegistryProviderPlatformCreate,
+		Read:   resourceTFERegistryProviderPlatformRead,
+		Delete: resourceTFERegistryProviderPlatformDelete,
+
+		CustomizeDiff: resourceTFERegistryProviderPlatformCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"provider_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"os": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"arch": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"binary_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"filename": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"shasum": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"binary_uploaded": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFERegistryProviderPlatformCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutCreate)
+	defer cancel()
+
+	// Get the version ID, OS, architecture and local binary.
+	versionID := registryProviderVersionID(d)
+	osName := d.Get("os").(string)
+	arch := d.Get("arch").(string)
+	binaryFile := d.Get("binary_file").(string)
+
+	// Calculate the SHA256 checksum of the binary.
+	shasum, err := fileSHA256(binaryFile)
+	if err != nil {
+		return fmt.Errorf("Error calculating the SHA256 checksum of %s: %v", binaryFile, err)
+	}
+
+	// If a checksum is configured, make sure it matches the binary.
+	if v, ok := d.GetOk("shasum"); ok && v.(string) != shasum {
+		return fmt.Errorf(
+			"Configured shasum %s does not match the SHA256 checksum of %s: %s", v.(string), binaryFile, shasum)
+	}
+
+	filename := filepath.Base(binaryFile)
+	if v, ok := d.GetOk("filename"); ok {
+		filename = v.(string)
+	}
+
+	// Create a new options struct.
+	options := tfe.RegistryProviderPlatformCreateOptions{
+		OS:       osName,
+		Arch:     arch,
+		Shasum:   shasum,
+		Filename: filename,
+	}
+
+	log.Printf("[DEBUG] Create %s_%s platform for version %s of registry provider: %s",
+		osName, arch, versionID.Version, versionID.Name)
+	platform, err := tfeClient.RegistryProviderPlatforms.Create(ctx, versionID, options)
+	if err != nil {
+		return fmt.Errorf("Error creating %s_%s platform for version %s of registry provider %s: %v",
+			osName, arch, versionID.Version, versionID.Name, err)
+	}
+
+	// Set the ID before uploading the binary, so a failed upload results in
+	// a tainted resource instead of an unmanaged platform.
+	d.SetId(platform.ID)
+
+	uploadURL, err := platform.ProviderBinaryUploadURL()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the binary upload URL of platform %s: %v", platform.ID, err)
+	}
+
+	log.Printf("[DEBUG] Upload binary %s for platform: %s", binaryFile, platform.ID)
+	if err := uploadRegistryFile(ctx, config.HTTPClient, uploadURL, binaryFile); err != nil {
+		return fmt.Errorf("Error uploading binary of platform %s: %v", platform.ID, err)
+	}
+
+	return resourceTFERegistryProviderPlatformRead(d, meta)
+}
+
+func resourceTFERegistryProviderPlatformRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutRead)
+	defer cancel()
+
+	log.Printf("[DEBUG] Read configuration of registry provider platform: %s", d.Id())
+	platform, err := tfeClient.RegistryProviderPlatforms.Read(ctx, registryProviderPlatformID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Registry provider platform %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of registry provider platform %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("os", platform.OS)
+	d.Set("arch", platform.Arch)
+	d.Set("filename", platform.Filename)
+	d.Set("shasum", platform.Shasum)
+	d.Set("binary_uploaded", platform.ProviderBinaryUploaded)
+
+	return nil
+}
+
+func resourceTFERegistryProviderPlatformDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutDelete)
+	defer cancel()
+
+	log.Printf("[DEBUG] Delete registry provider platform: %s", d.Id())
+	err := tfeClient.RegistryProviderPlatforms.Delete(ctx, registryProviderPlatformID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting registry provider platform %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func registryProviderPlatformID(d *schema.ResourceData) tfe.RegistryProviderPlatformID {
+	return tfe.RegistryProviderPlatformID{
+		RegistryProviderVersionID: registryProviderVersionID(d),
+		OS:                        d.Get("os").(string),
+		Arch:                      d.Get("arch").(string),
+	}
+}
+
+func resourceTFERegistryProviderPlatformCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffOrganization(d, meta); err != nil {
+		return err
+	}
+
+	// The binary of a new platform is checked when it is uploaded, and
+	// unknown values will be known when applying.
+	if d.Id() == "" || !d.NewValueKnown("binary_file") || !d.NewValueKnown("shasum") {
+		return nil
+	}
+
+	binaryFile := d.Get("binary_file").(string)
+	shasum, err := fileSHA256(binaryFile)
+	if err != nil {
+		return fmt.Errorf("Error calculating the SHA256 checksum of %s: %v", binaryFile, err)
+	}
+
+	// A configured checksum must match the binary.
+	if d.HasChange("shasum") {
+		if v := d.Get("shasum").(string); v != shasum {
+			return fmt.Errorf(
+				"Configured shasum %s does not match the SHA256 checksum of %s: %s", v, binaryFile, shasum)
+		}
+		return nil
+	}
+
+	// Otherwise the checksum in the state is the one of the uploaded binary,
+	// so a new binary at the same path forces a new platform.
+	if d.Get("shasum").(string) == shasum {
+		return nil
+	}
+	if err := d.SetNew("shasum", shasum); err != nil {
+		return err
+	}
+
+	return d.ForceNew("shasum")
+}
+
+// fileSHA256 returns the hex encoded SHA256 checksum of a local file.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
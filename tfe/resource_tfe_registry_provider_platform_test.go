@@ -0,0 +1,196 @@
+package tfe
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFERegistryProviderPlatform_basic(t *testing.T) {
+	platform := &tfe.RegistryProviderPlatform{}
+	dir := testAccRegistryProviderFiles(t)
+	defer os.RemoveAll(dir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryProviderPlatformDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFERegistryProviderPlatform_basic(dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryProviderPlatformExists(
+						"tfe_registry_provider_platform.foobar", platform),
+					testAccCheckTFERegistryProviderPlatformAttributes(platform),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_platform.foobar", "os", "linux"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_platform.foobar", "arch", "amd64"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_platform.foobar", "filename",
+						"terraform-provider-provider-test_1.0.0_linux_amd64.zip"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_platform.foobar", "shasum", testSHA256("binary")),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_platform.foobar", "binary_uploaded", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegistryProviderPlatform_changedBinary(t *testing.T) {
+	dir := testAccRegistryProviderFiles(t)
+	defer os.RemoveAll(dir)
+
+	binaryFile := filepath.Join(dir, "terraform-provider-provider-test_1.0.0_linux_amd64.zip")
+
+	state := &terraform.InstanceState{
+		ID: "provpltfrm-123",
+		Attributes: map[string]string{
+			"id":            "provpltfrm-123",
+			"organization":  "terraform-test",
+			"provider_name": "provider-test",
+			"version":       "1.0.0",
+			"os":            "linux",
+			"arch":          "amd64",
+			"binary_file":   binaryFile,
+			"filename":      filepath.Base(binaryFile),
+			"shasum":        testSHA256("binary"),
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"organization":  "terraform-test",
+		"provider_name": "provider-test",
+		"version":       "1.0.0",
+		"os":            "linux",
+		"arch":          "amd64",
+		"binary_file":   binaryFile,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg := terraform.NewResourceConfig(raw)
+
+	r := resourceTFERegistryProviderPlatform()
+	meta := &ConfiguredClient{Organization: "terraform-test"}
+
+	diff, err := r.Diff(state, cfg, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no diff for an unchanged binary, got: %#v", diff)
+	}
+
+	// Build a new binary with the same file name.
+	if err := ioutil.WriteFile(binaryFile, []byte("changed"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	diff, err = r.Diff(state, cfg, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a changed binary to force a new resource, got: %#v", diff)
+	}
+	if attr, ok := diff.Attributes["shasum"]; !ok || !attr.RequiresNew {
+		t.Fatalf("expected the checksum to force a new resource, got: %#v", attr)
+	}
+}
+
+func testAccCheckTFERegistryProviderPlatformExists(
+	n string, platform *tfe.RegistryProviderPlatform) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		p, err := tfeClient.RegistryProviderPlatforms.Read(ctx, testAccRegistryProviderPlatformID(rs))
+		if err != nil {
+			return err
+		}
+
+		if p.ID != rs.Primary.ID {
+			return fmt.Errorf("Registry provider platform not found")
+		}
+
+		*platform = *p
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryProviderPlatformAttributes(
+	platform *tfe.RegistryProviderPlatform) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if platform.OS != "linux" || platform.Arch != "amd64" {
+			return fmt.Errorf("Bad platform: %s_%s", platform.OS, platform.Arch)
+		}
+
+		if !platform.ProviderBinaryUploaded {
+			return fmt.Errorf("Bad binary uploaded: %t", platform.ProviderBinaryUploaded)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryProviderPlatformDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_registry_provider_platform" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.RegistryProviderPlatforms.Read(ctx, testAccRegistryProviderPlatformID(rs))
+		if err == nil {
+			return fmt.Errorf("Registry provider platform %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRegistryProviderPlatformID(rs *terraform.ResourceState) tfe.RegistryProviderPlatformID {
+	return tfe.RegistryProviderPlatformID{
+		RegistryProviderVersionID: testAccRegistryProviderVersionID(rs),
+		OS:                        rs.Primary.Attributes["os"],
+		Arch:                      rs.Primary.Attributes["arch"],
+	}
+}
+
+func testAccTFERegistryProviderPlatform_basic(dir string) string {
+	return fmt.Sprintf(`%s
+
+resource "tfe_registry_provider_platform" "foobar" {
+  organization = "${tfe_organization.foobar.id}"
+  provider_name = "${tfe_registry_provider.foobar.name}"
+  version = "${tfe_registry_provider_version.foobar.version}"
+  os = "linux"
+  arch = "amd64"
+  binary_file = "%s"
+}`, testAccTFERegistryProviderVersion_basic(dir),
+		filepath.Join(dir, "terraform-provider-provider-test_1.0.0_linux_amd64.zip"))
+}
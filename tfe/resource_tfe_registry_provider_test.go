@@ -0,0 +1,181 @@
+package tfe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFERegistryProvider_private(t *testing.T) {
+	provider := &tfe.RegistryProvider{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryProviderDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFERegistryProvider_private,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryProviderExists(
+						"tfe_registry_provider.foobar", provider),
+					testAccCheckTFERegistryProviderAttributes(provider),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider.foobar", "name", "provider-test"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider.foobar", "registry_name", "private"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider.foobar", "namespace", "terraform-test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFERegistryProvider_public(t *testing.T) {
+	provider := &tfe.RegistryProvider{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryProviderDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFERegistryProvider_public,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryProviderExists(
+						"tfe_registry_provider.foobar", provider),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider.foobar", "name", "aws"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider.foobar", "registry_name", "public"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider.foobar", "namespace", "hashicorp"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFERegistryProvider_privateNamespace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryProviderDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccTFERegistryProvider_privateNamespace,
+				ExpectError: regexp.MustCompile(`namespace can only be set for providers in the public registry`),
+			},
+		},
+	})
+}
+
+func testAccCheckTFERegistryProviderExists(
+	n string, provider *tfe.RegistryProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		p, err := tfeClient.RegistryProviders.Read(ctx, testAccRegistryProviderID(rs), nil)
+		if err != nil {
+			return err
+		}
+
+		if p.ID != rs.Primary.ID {
+			return fmt.Errorf("Registry provider not found")
+		}
+
+		*provider = *p
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryProviderAttributes(
+	provider *tfe.RegistryProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if provider.Name != "provider-test" {
+			return fmt.Errorf("Bad name: %s", provider.Name)
+		}
+
+		if provider.RegistryName != tfe.PrivateRegistry {
+			return fmt.Errorf("Bad registry name: %s", provider.RegistryName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryProviderDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_registry_provider" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.RegistryProviders.Read(ctx, testAccRegistryProviderID(rs), nil)
+		if err == nil {
+			return fmt.Errorf("Registry provider %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRegistryProviderID(rs *terraform.ResourceState) tfe.RegistryProviderID {
+	return tfe.RegistryProviderID{
+		OrganizationName: rs.Primary.Attributes["organization"],
+		RegistryName:     tfe.RegistryName(rs.Primary.Attributes["registry_name"]),
+		Namespace:        rs.Primary.Attributes["namespace"],
+		Name:             rs.Primary.Attributes["name"],
+	}
+}
+
+const testAccTFERegistryProvider_private = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_provider" "foobar" {
+  name = "provider-test"
+  organization = "${tfe_organization.foobar.id}"
+}`
+
+const testAccTFERegistryProvider_public = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_provider" "foobar" {
+  name = "aws"
+  organization = "${tfe_organization.foobar.id}"
+  registry_name = "public"
+  namespace = "hashicorp"
+}`
+
+const testAccTFERegistryProvider_privateNamespace = `
+resource "tfe_registry_provider" "foobar" {
+  name = "provider-test"
+  organization = "terraform-test"
+  namespace = "hashicorp"
+}`
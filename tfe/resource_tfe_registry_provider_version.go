@@ -0,0 +1,317 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTFERegistryProviderVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFERegistryProviderVersionCreate,
+		Read:   resourceTFERegistryProviderVersionRead,
+		Delete: resourceTFERegistryProviderVersionDelete,
+
+		CustomizeDiff: resourceTFERegistryProviderVersionCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"organization": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"provider_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocols": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"shasums_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"shasums_sig_file": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"shasums_file_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"shasums_sig_file_sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"shasums_uploaded": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"shasums_sig_uploaded": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTFERegistryProviderVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutCreate)
+	defer cancel()
+
+	// Get the provider ID and version.
+	providerID := privateRegistryProviderID(d)
+	version := d.Get("version").(string)
+
+	// Create a new options struct.
+	options := tfe.RegistryProviderVersionCreateOptions{
+		Version: version,
+		KeyID:   d.Get("key_id").(string),
+	}
+
+	for _, protocol := range d.Get("protocols").([]interface{}) {
+		options.Protocols = append(options.Protocols, protocol.(string))
+	}
+
+	log.Printf("[DEBUG] Create version %s of registry provider: %s", version, providerID.Name)
+	pv, err := tfeClient.RegistryProviderVersions.Create(ctx, providerID, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error creating version %s of registry provider %s: %v", version, providerID.Name, err)
+	}
+
+	// Set the ID before uploading the files, so a failed upload results in
+	// a tainted resource instead of an unmanaged provider version.
+	d.SetId(pv.ID)
+
+	shasumsURL, err := pv.ShasumsUploadURL()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the SHA256SUMS upload URL of version %s: %v", version, err)
+	}
+
+	log.Printf("[DEBUG] Upload SHA256SUMS file for version %s of registry provider: %s", version, providerID.Name)
+	if err := uploadRegistryFile(ctx, config.HTTPClient, shasumsURL, d.Get("shasums_file").(string)); err != nil {
+		return fmt.Errorf("Error uploading SHA256SUMS file of version %s: %v", version, err)
+	}
+	setFileSHA256(d, "shasums_file", "shasums_file_sha256")
+
+	shasumsSigURL, err := pv.ShasumsSigUploadURL()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the SHA256SUMS.sig upload URL of version %s: %v", version, err)
+	}
+
+	log.Printf("[DEBUG] Upload SHA256SUMS.sig file for version %s of registry provider: %s", version, providerID.Name)
+	if err := uploadRegistryFile(ctx, config.HTTPClient, shasumsSigURL, d.Get("shasums_sig_file").(string)); err != nil {
+		return fmt.Errorf("Error uploading SHA256SUMS.sig file of version %s: %v", version, err)
+	}
+	setFileSHA256(d, "shasums_sig_file", "shasums_sig_file_sha256")
+
+	return resourceTFERegistryProviderVersionRead(d, meta)
+}
+
+func resourceTFERegistryProviderVersionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutRead)
+	defer cancel()
+
+	log.Printf("[DEBUG] Read configuration of registry provider version: %s", d.Id())
+	pv, err := tfeClient.RegistryProviderVersions.Read(ctx, registryProviderVersionID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Registry provider version %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of registry provider version %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("version", pv.Version)
+	d.Set("key_id", pv.KeyID)
+	d.Set("protocols", pv.Protocols)
+	d.Set("shasums_uploaded", pv.ShasumsUploaded)
+	d.Set("shasums_sig_uploaded", pv.ShasumsSigUploaded)
+
+	// Versions created before the checksums of the files were tracked get
+	// the checksums of the current files.
+	if d.Get("shasums_file_sha256").(string) == "" {
+		setFileSHA256(d, "shasums_file", "shasums_file_sha256")
+	}
+	if d.Get("shasums_sig_file_sha256").(string) == "" {
+		setFileSHA256(d, "shasums_sig_file", "shasums_sig_file_sha256")
+	}
+
+	return nil
+}
+
+func resourceTFERegistryProviderVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutDelete)
+	defer cancel()
+
+	log.Printf("[DEBUG] Delete registry provider version: %s", d.Id())
+	err := tfeClient.RegistryProviderVersions.Delete(ctx, registryProviderVersionID(d))
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting registry provider version %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+// privateRegistryProviderID returns the composite ID of the provider a
+// version or platform belongs to. Versions and platforms can only be
+// uploaded to providers in the private registry, which always use the
+// organization name as their namespace.
+func privateRegistryProviderID(d *schema.ResourceData) tfe.RegistryProviderID {
+	organization := d.Get("organization").(string)
+
+	return tfe.RegistryProviderID{
+		OrganizationName: organization,
+		RegistryName:     tfe.PrivateRegistry,
+		Namespace:        organization,
+		Name:             d.Get("provider_name").(string),
+	}
+}
+
+func registryProviderVersionID(d *schema.ResourceData) tfe.RegistryProviderVersionID {
+	return tfe.RegistryProviderVersionID{
+		RegistryProviderID: privateRegistryProviderID(d),
+		Version:            d.Get("version").(string),
+	}
+}
+
+func resourceTFERegistryProviderVersionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffOrganization(d, meta); err != nil {
+		return err
+	}
+	if err := customizeDiffFileSHA256(d, "shasums_file", "shasums_file_sha256"); err != nil {
+		return err
+	}
+	return customizeDiffFileSHA256(d, "shasums_sig_file", "shasums_sig_file_sha256")
+}
+
+// customizeDiffFileSHA256 forces a new resource when the SHA256 checksum of
+// the local file at pathKey differs from the checksum in shaKey. The paths of
+// uploaded files often stay the same when a new build is uploaded.
+func customizeDiffFileSHA256(d *schema.ResourceDiff, pathKey, shaKey string) error {
+	// The checksums of a new resource are set when uploading the files, and
+	// unknown values will be known when applying.
+	if d.Id() == "" || !d.NewValueKnown(pathKey) {
+		return nil
+	}
+
+	// Resources without a checksum in the state get one when refreshed.
+	old := d.Get(shaKey).(string)
+	if old == "" {
+		return nil
+	}
+
+	path := d.Get(pathKey).(string)
+	shasum, err := fileSHA256(path)
+	if err != nil {
+		return fmt.Errorf("Error calculating the SHA256 checksum of %s: %v", path, err)
+	}
+	if shasum == old {
+		return nil
+	}
+
+	if err := d.SetNew(shaKey, shasum); err != nil {
+		return err
+	}
+
+	return d.ForceNew(shaKey)
+}
+
+// setFileSHA256 sets shaKey to the SHA256 checksum of the local file at
+// pathKey. A file that can't be read leaves shaKey unset.
+func setFileSHA256(d *schema.ResourceData, pathKey, shaKey string) {
+	path := d.Get(pathKey).(string)
+	shasum, err := fileSHA256(path)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to calculate the SHA256 checksum of %s: %v", path, err)
+		return
+	}
+
+	d.Set(shaKey, shasum)
+}
+
+// uploadRegistryFile uploads the content of a local file to one of the
+// upload URLs returned by the registry API. The file is streamed instead of
+// read into memory, so a failed upload is not retried.
+func uploadRegistryFile(ctx context.Context, client *http.Client, url, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", url, f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = fi.Size()
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := client.Do(req.WithContext(withStreamedBody(ctx)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status uploading %s: %s", path, resp.Status)
+	}
+
+	return nil
+}
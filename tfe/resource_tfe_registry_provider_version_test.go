@@ -0,0 +1,329 @@
+package tfe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFERegistryProviderVersion_basic(t *testing.T) {
+	version := &tfe.RegistryProviderVersion{}
+	dir := testAccRegistryProviderFiles(t)
+	defer os.RemoveAll(dir)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFERegistryProviderVersionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFERegistryProviderVersion_basic(dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFERegistryProviderVersionExists(
+						"tfe_registry_provider_version.foobar", version),
+					testAccCheckTFERegistryProviderVersionAttributes(version),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_version.foobar", "version", "1.0.0"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_version.foobar", "protocols.#", "1"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_version.foobar", "shasums_uploaded", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_version.foobar", "shasums_sig_uploaded", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_registry_provider_version.foobar", "shasums_file_sha256",
+						testFileSHA256(t, filepath.Join(dir, "SHA256SUMS"))),
+				),
+			},
+		},
+	})
+}
+
+func TestRegistryProviderVersion_changedFile(t *testing.T) {
+	dir := testAccRegistryProviderFiles(t)
+	defer os.RemoveAll(dir)
+
+	shasumsFile := filepath.Join(dir, "SHA256SUMS")
+	shasumsSigFile := filepath.Join(dir, "SHA256SUMS.sig")
+
+	state := &terraform.InstanceState{
+		ID: "provver-123",
+		Attributes: map[string]string{
+			"id":                      "provver-123",
+			"organization":            "terraform-test",
+			"provider_name":           "provider-test",
+			"version":                 "1.0.0",
+			"key_id":                  "ABCDEF0123456789",
+			"protocols.#":             "1",
+			"protocols.0":             "5.0",
+			"shasums_file":            shasumsFile,
+			"shasums_sig_file":        shasumsSigFile,
+			"shasums_file_sha256":     testFileSHA256(t, shasumsFile),
+			"shasums_sig_file_sha256": testFileSHA256(t, shasumsSigFile),
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"organization":     "terraform-test",
+		"provider_name":    "provider-test",
+		"version":          "1.0.0",
+		"key_id":           "ABCDEF0123456789",
+		"protocols":        []interface{}{"5.0"},
+		"shasums_file":     shasumsFile,
+		"shasums_sig_file": shasumsSigFile,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg := terraform.NewResourceConfig(raw)
+
+	r := resourceTFERegistryProviderVersion()
+	meta := &ConfiguredClient{Organization: "terraform-test"}
+
+	diff, err := r.Diff(state, cfg, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no diff for unchanged files, got: %#v", diff)
+	}
+
+	// Upload a new build with the same file names.
+	if err := ioutil.WriteFile(shasumsFile, []byte("changed"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	diff, err = r.Diff(state, cfg, meta)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected a changed file to force a new resource, got: %#v", diff)
+	}
+	if attr, ok := diff.Attributes["shasums_file_sha256"]; !ok || !attr.RequiresNew {
+		t.Fatalf("expected the checksum to force a new resource, got: %#v", attr)
+	}
+}
+
+func TestUploadRegistryFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "SHA256SUMS")
+	if err := ioutil.WriteFile(path, []byte("payload"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var uploaded string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected a PUT request, got: %s", r.Method)
+		}
+		if r.ContentLength != int64(len("payload")) {
+			t.Errorf("expected a content length of %d, got: %d", len("payload"), r.ContentLength)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		uploaded = string(body)
+
+		if strings.HasSuffix(r.URL.Path, "/forbidden") {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer ts.Close()
+
+	// Upload the file.
+	if err := uploadRegistryFile(ctx, ts.Client(), ts.URL+"/upload", path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uploaded != "payload" {
+		t.Fatalf("expected the file content to be uploaded, got: %q", uploaded)
+	}
+
+	// An upload that is refused returns an error.
+	err = uploadRegistryFile(ctx, ts.Client(), ts.URL+"/forbidden", path)
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected an error with the status, got: %v", err)
+	}
+
+	// A missing file is never uploaded.
+	uploaded = ""
+	err = uploadRegistryFile(ctx, ts.Client(), ts.URL+"/upload", filepath.Join(dir, "missing"))
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected a not exist error, got: %v", err)
+	}
+	if uploaded != "" {
+		t.Fatalf("expected nothing to be uploaded, got: %q", uploaded)
+	}
+}
+
+func testAccCheckTFERegistryProviderVersionExists(
+	n string, version *tfe.RegistryProviderVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		v, err := tfeClient.RegistryProviderVersions.Read(ctx, testAccRegistryProviderVersionID(rs))
+		if err != nil {
+			return err
+		}
+
+		if v.ID != rs.Primary.ID {
+			return fmt.Errorf("Registry provider version not found")
+		}
+
+		*version = *v
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryProviderVersionAttributes(
+	version *tfe.RegistryProviderVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if version.Version != "1.0.0" {
+			return fmt.Errorf("Bad version: %s", version.Version)
+		}
+
+		if !version.ShasumsUploaded || !version.ShasumsSigUploaded {
+			return fmt.Errorf("Bad uploads: shasums %t, shasums sig %t",
+				version.ShasumsUploaded, version.ShasumsSigUploaded)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFERegistryProviderVersionDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_registry_provider_version" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.RegistryProviderVersions.Read(ctx, testAccRegistryProviderVersionID(rs))
+		if err == nil {
+			return fmt.Errorf("Registry provider version %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRegistryProviderVersionID(rs *terraform.ResourceState) tfe.RegistryProviderVersionID {
+	organization := rs.Primary.Attributes["organization"]
+
+	return tfe.RegistryProviderVersionID{
+		RegistryProviderID: tfe.RegistryProviderID{
+			OrganizationName: organization,
+			RegistryName:     tfe.PrivateRegistry,
+			Namespace:        organization,
+			Name:             rs.Primary.Attributes["provider_name"],
+		},
+		Version: rs.Primary.Attributes["version"],
+	}
+}
+
+// testAccRegistryProviderFiles writes the files of a provider release to a
+// new temporary directory, which the caller must remove.
+func testAccRegistryProviderFiles(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	files := map[string]string{
+		"terraform-provider-provider-test_1.0.0_linux_amd64.zip": "binary",
+		"SHA256SUMS.sig": "signature",
+	}
+	files["SHA256SUMS"] = fmt.Sprintf("%s  terraform-provider-provider-test_1.0.0_linux_amd64.zip\n",
+		testSHA256("binary"))
+
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			os.RemoveAll(dir)
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	return dir
+}
+
+func testFileSHA256(t *testing.T, path string) string {
+	shasum, err := fileSHA256(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return shasum
+}
+
+func testSHA256(content string) string {
+	h := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
+
+func testAccTFERegistryProviderVersion_basic(dir string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_registry_gpg_key" "foobar" {
+  organization = "${tfe_organization.foobar.id}"
+  ascii_armor = <<EOF
+-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatY7YBYJKwYBBAHaRw8BAQdAdT7rLKP/62YkjdEGFubGlofUn2WIpNy4UFYM
+XFKdo7q0IVRlcnJhZm9ybSBUZXN0IDx0ZXN0QGV4YW1wbGUuY29tPoiQBBMWCAA4
+FiEEgsdy2yoNsQ88cFtaZZTvAa2GBuEFAmrWO2ACGwMFCwkIBwIGFQoJCAsCBBYC
+AwECHgECF4AACgkQZZTvAa2GBuEMRgEAs8GdIGoBYOmF2q3520nkY2ErJLdCR6xX
+9MNca/C9zs8A+wTEQjUBpqea+azZDVQiO6EGU0J/m69/D/4YTH/jURgH
+=0+nd
+-----END PGP PUBLIC KEY BLOCK-----
+EOF
+}
+
+resource "tfe_registry_provider" "foobar" {
+  name = "provider-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_registry_provider_version" "foobar" {
+  organization = "${tfe_organization.foobar.id}"
+  provider_name = "${tfe_registry_provider.foobar.name}"
+  version = "1.0.0"
+  key_id = "${tfe_registry_gpg_key.foobar.key_id}"
+  protocols = ["5.0"]
+  shasums_file = "%s"
+  shasums_sig_file = "%s"
+}`, filepath.Join(dir, "SHA256SUMS"), filepath.Join(dir, "SHA256SUMS.sig"))
+}
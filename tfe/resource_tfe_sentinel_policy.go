@@ -16,6 +16,8 @@ func resourceTFESentinelPolicy() *schema.Resource {
 		Update: resourceTFESentinelPolicyUpdate,
 		Delete: resourceTFESentinelPolicyDelete,
 
+		CustomizeDiff: customizeDiffOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -25,7 +27,8 @@ func resourceTFESentinelPolicy() *schema.Resource {
 
 			"organization": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -52,7 +55,9 @@ func resourceTFESentinelPolicy() *schema.Resource {
 }
 
 func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
@@ -89,7 +94,9 @@ func resourceTFESentinelPolicyCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read sentinel policy: %s", d.Id())
 	policy, err := tfeClient.Policies.Read(ctx, d.Id())
@@ -119,7 +126,9 @@ func resourceTFESentinelPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	if d.HasChange("enforce_mode") {
 		// Create a new options struct.
@@ -153,7 +162,9 @@ func resourceTFESentinelPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTFESentinelPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete sentinel policy: %s", d.Id())
 	err := tfeClient.Policies.Delete(ctx, d.Id())
@@ -15,6 +15,8 @@ func resourceTFESSHKey() *schema.Resource {
 		Update: resourceTFESSHKeyUpdate,
 		Delete: resourceTFESSHKeyDelete,
 
+		CustomizeDiff: customizeDiffOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -23,7 +25,8 @@ func resourceTFESSHKey() *schema.Resource {
 
 			"organization": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -37,7 +40,9 @@ func resourceTFESSHKey() *schema.Resource {
 }
 
 func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
@@ -62,7 +67,9 @@ func resourceTFESSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read configuration of SSH key: %s", d.Id())
 	sshKey, err := tfeClient.SSHKeys.Read(ctx, d.Id())
@@ -82,7 +89,9 @@ func resourceTFESSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFESSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Create a new options struct.
 	options := tfe.SSHKeyUpdateOptions{
@@ -100,7 +109,9 @@ func resourceTFESSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFESSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete SSH key: %s", d.Id())
 	err := tfeClient.SSHKeys.Delete(ctx, d.Id())
@@ -14,6 +14,8 @@ func resourceTFETeam() *schema.Resource {
 		Read:   resourceTFETeamRead,
 		Delete: resourceTFETeamDelete,
 
+		CustomizeDiff: customizeDiffOrganization,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -23,7 +25,8 @@ func resourceTFETeam() *schema.Resource {
 
 			"organization": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 		},
@@ -31,7 +34,9 @@ func resourceTFETeam() *schema.Resource {
 }
 
 func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
@@ -55,7 +60,9 @@ func resourceTFETeamCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read configuration of team: %s", d.Id())
 	_, err := tfeClient.Teams.Read(ctx, d.Id())
@@ -72,7 +79,9 @@ func resourceTFETeamRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFETeamDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete team: %s", d.Id())
 	err := tfeClient.Teams.Delete(ctx, d.Id())
@@ -46,7 +46,9 @@ func resourceTFETeamAccess() *schema.Resource {
 }
 
 func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get access, team ID, workspace and organization.
 	access := d.Get("access").(string)
@@ -86,7 +88,9 @@ func resourceTFETeamAccessCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read configuration of team access: %s", d.Id())
 	tmAccess, err := tfeClient.TeamAccess.Read(ctx, d.Id())
@@ -112,7 +116,9 @@ func resourceTFETeamAccessRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFETeamAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete team access: %s", d.Id())
 	err := tfeClient.TeamAccess.Remove(ctx, d.Id())
@@ -32,7 +32,9 @@ func resourceTFETeamMember() *schema.Resource {
 }
 
 func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the team ID and username..
 	teamID := d.Get("team_id").(string)
@@ -55,7 +57,9 @@ func resourceTFETeamMemberCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the team ID and username..
 	teamID, username := unpackTeamMemberID(d.Id())
@@ -88,7 +92,9 @@ func resourceTFETeamMemberRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFETeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the team ID and username..
 	teamID, username := unpackTeamMemberID(d.Id())
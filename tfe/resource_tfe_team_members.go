@@ -32,7 +32,9 @@ func resourceTFETeamMembers() *schema.Resource {
 }
 
 func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the team ID and username..
 	teamID := d.Get("team_id").(string)
@@ -57,7 +59,9 @@ func resourceTFETeamMembersCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the team ID and username..
 	teamID := d.Get("team_id").(string)
@@ -89,7 +93,9 @@ func resourceTFETeamMembersRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	if d.HasChange("usernames") {
 		old, new := d.GetChange("usernames")
@@ -138,7 +144,9 @@ func resourceTFETeamMembersUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTFETeamMembersDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Retrieve users to remove from team: %s", d.Id())
 	users, err := tfeClient.TeamMembers.List(ctx, d.Id())
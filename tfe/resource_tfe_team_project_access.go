@@ -0,0 +1,158 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceTFETeamProjectAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFETeamProjectAccessCreate,
+		Read:   resourceTFETeamProjectAccessRead,
+		Update: resourceTFETeamProjectAccessUpdate,
+		Delete: resourceTFETeamProjectAccessDelete,
+
+		Schema: map[string]*schema.Schema{
+			"access": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.TeamProjectAccessAdmin),
+						string(tfe.TeamProjectAccessMaintain),
+						string(tfe.TeamProjectAccessWrite),
+						string(tfe.TeamProjectAccessRead),
+					},
+					false,
+				),
+			},
+
+			"team_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTFETeamProjectAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get access, team ID and project ID.
+	access := d.Get("access").(string)
+	teamID := d.Get("team_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	// Get the team.
+	tm, err := tfeClient.Teams.Read(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving team %s: %v", teamID, err)
+	}
+
+	// Get the project.
+	project, err := tfeClient.Projects.Read(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving project %s: %v", projectID, err)
+	}
+
+	// Create a new options struct.
+	options := tfe.TeamProjectAccessAddOptions{
+		Access:  tfe.TeamProjectAccessType(access),
+		Team:    tm,
+		Project: project,
+	}
+
+	log.Printf("[DEBUG] Give team %s %s access to project: %s", tm.Name, access, project.Name)
+	tmAccess, err := tfeClient.TeamProjectAccess.Add(ctx, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error giving team %s %s access to project %s: %v", tm.Name, access, project.Name, err)
+	}
+
+	d.SetId(tmAccess.ID)
+
+	return resourceTFETeamProjectAccessRead(d, meta)
+}
+
+func resourceTFETeamProjectAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Read configuration of team project access: %s", d.Id())
+	tmAccess, err := tfeClient.TeamProjectAccess.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Team project access %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of team project access %s: %v", d.Id(), err)
+	}
+
+	// Update config.
+	d.Set("access", string(tmAccess.Access))
+
+	if tmAccess.Team != nil {
+		d.Set("team_id", tmAccess.Team.ID)
+	} else {
+		d.Set("team_id", "")
+	}
+
+	if tmAccess.Project != nil {
+		d.Set("project_id", tmAccess.Project.ID)
+	} else {
+		d.Set("project_id", "")
+	}
+
+	return nil
+}
+
+func resourceTFETeamProjectAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Create a new options struct.
+	options := tfe.TeamProjectAccessUpdateOptions{
+		Access: tfe.ProjectAccess(tfe.TeamProjectAccessType(d.Get("access").(string))),
+	}
+
+	log.Printf("[DEBUG] Update team project access: %s", d.Id())
+	_, err := tfeClient.TeamProjectAccess.Update(ctx, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating team project access %s: %v", d.Id(), err)
+	}
+
+	return resourceTFETeamProjectAccessRead(d, meta)
+}
+
+func resourceTFETeamProjectAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	log.Printf("[DEBUG] Delete team project access: %s", d.Id())
+	err := tfeClient.TeamProjectAccess.Remove(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting team project access %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccTFETeamProjectAccess_basic(t *testing.T) {
+	tmAccess := &tfe.TeamProjectAccess{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamProjectAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFETeamProjectAccess_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributes(tmAccess, tfe.TeamProjectAccessRead),
+					resource.TestCheckResourceAttr(
+						"tfe_team_project_access.foobar", "access", "read"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFETeamProjectAccess_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamProjectAccessExists(
+						"tfe_team_project_access.foobar", tmAccess),
+					testAccCheckTFETeamProjectAccessAttributes(tmAccess, tfe.TeamProjectAccessMaintain),
+					resource.TestCheckResourceAttr(
+						"tfe_team_project_access.foobar", "access", "maintain"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFETeamProjectAccessExists(
+	n string, tmAccess *tfe.TeamProjectAccess) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		ta, err := tfeClient.TeamProjectAccess.Read(ctx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if ta == nil {
+			return fmt.Errorf("Team project access not found")
+		}
+
+		*tmAccess = *ta
+
+		return nil
+	}
+}
+
+func testAccCheckTFETeamProjectAccessAttributes(
+	tmAccess *tfe.TeamProjectAccess, access tfe.TeamProjectAccessType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if tmAccess.Access != access {
+			return fmt.Errorf("Bad access: %s", tmAccess.Access)
+		}
+		return nil
+	}
+}
+
+func testAccCheckTFETeamProjectAccessDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_team_project_access" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		_, err := tfeClient.TeamProjectAccess.Read(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Team project access %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccTFETeamProjectAccess_basic = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name = "team-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_project" "foobar" {
+  name = "project-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_team_project_access" "foobar" {
+  access = "read"
+  team_id = "${tfe_team.foobar.id}"
+  project_id = "${tfe_project.foobar.id}"
+}`
+
+const testAccTFETeamProjectAccess_update = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name = "team-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_project" "foobar" {
+  name = "project-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_team_project_access" "foobar" {
+  access = "maintain"
+  team_id = "${tfe_team.foobar.id}"
+  project_id = "${tfe_project.foobar.id}"
+}`
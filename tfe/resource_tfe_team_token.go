@@ -3,6 +3,7 @@ package tfe
 import (
 	"fmt"
 	"log"
+	"time"
 
 	tfe "github.com/HappyPathway/go-tfe"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -12,8 +13,11 @@ func resourceTFETeamToken() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTFETeamTokenCreate,
 		Read:   resourceTFETeamTokenRead,
+		Update: resourceTFETeamTokenUpdate,
 		Delete: resourceTFETeamTokenDelete,
 
+		CustomizeDiff: resourceTFETeamTokenCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"team_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -32,12 +36,37 @@ func resourceTFETeamToken() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"rotate_after": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRotateAfter,
+			},
+
+			"keepers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"token_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get the team ID.
 	teamID := d.Get("team_id").(string)
@@ -69,14 +98,21 @@ func resourceTFETeamTokenCreate(d *schema.ResourceData, meta interface{}) error
 	// only be returned once during the creation of the token.
 	d.Set("token", token.Token)
 
+	// Keep track of the generated token, so we can detect when the token is
+	// regenerated outside of Terraform.
+	d.Set("token_id", token.ID)
+	d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+
 	return resourceTFETeamTokenRead(d, meta)
 }
 
 func resourceTFETeamTokenRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Read the token from team: %s", d.Id())
-	_, err := tfeClient.TeamTokens.Read(ctx, d.Id())
+	token, err := tfeClient.TeamTokens.Read(ctx, d.Id())
 	if err != nil {
 		if err == tfe.ErrResourceNotFound {
 			log.Printf("[DEBUG] Token for team %s does no longer exist", d.Id())
@@ -86,11 +122,52 @@ func resourceTFETeamTokenRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading token from team %s: %v", d.Id(), err)
 	}
 
+	// Only fill in the token details for tokens created by an older version
+	// of this provider. Otherwise they are kept as generated, so a token that
+	// is regenerated outside of Terraform is detected when planning.
+	if d.Get("token_id").(string) == "" {
+		d.Set("token_id", token.ID)
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+
 	return nil
 }
 
+func resourceTFETeamTokenCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Nothing to compare against when the token is not created yet.
+	if d.Id() == "" {
+		return nil
+	}
+
+	if err := customizeTokenRotationDiff(d, meta); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Read the token from team: %s", d.Id())
+	token, err := tfeClient.TeamTokens.Read(ctx, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error reading token from team %s: %v", d.Id(), err)
+	}
+
+	return customizeTokenRegenerationDiff(d, token.ID)
+}
+
+func resourceTFETeamTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only the rotation window can be updated, which is not stored remotely.
+	return resourceTFETeamTokenRead(d, meta)
+}
+
 func resourceTFETeamTokenDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete token from team: %s", d.Id())
 	err := tfeClient.TeamTokens.Delete(ctx, d.Id())
@@ -80,10 +80,91 @@ func TestAccTFETeamToken_existsWithForce(t *testing.T) {
 	})
 }
 
+func TestAccTFETeamToken_keepers(t *testing.T) {
+	token := &tfe.TeamToken{}
+	var tokenID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamTokenDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFETeamToken_keepers("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					func(s *terraform.State) error {
+						tokenID = token.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr(
+						"tfe_team_token.foobar", "rotate_after", "90d"),
+					resource.TestCheckResourceAttrSet(
+						"tfe_team_token.foobar", "created_at"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFETeamToken_keepers("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					func(s *terraform.State) error {
+						if token.ID == tokenID {
+							return fmt.Errorf("Token was not regenerated after changing the keepers")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFETeamToken_regeneratedOutsideOfTerraform(t *testing.T) {
+	token := &tfe.TeamToken{}
+	var teamID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFETeamTokenDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFETeamToken_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					func(s *terraform.State) error {
+						teamID = s.RootModule().Resources["tfe_team_token.foobar"].Primary.ID
+						return nil
+					},
+				),
+			},
+
+			resource.TestStep{
+				PreConfig: func() {
+					tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+					if _, err := tfeClient.TeamTokens.Generate(ctx, teamID); err != nil {
+						t.Fatalf("Error regenerating team token: %v", err)
+					}
+				},
+				Config: testAccTFETeamToken_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFETeamTokenExists(
+						"tfe_team_token.foobar", token),
+					testAccCheckTFETeamTokenGenerated("tfe_team_token.foobar", token),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTFETeamTokenExists(
 	n string, token *tfe.TeamToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -109,8 +190,25 @@ func testAccCheckTFETeamTokenExists(
 	}
 }
 
+func testAccCheckTFETeamTokenGenerated(
+	n string, token *tfe.TeamToken) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["token_id"] != token.ID {
+			return fmt.Errorf(
+				"Bad token ID: %s, expected: %s", rs.Primary.Attributes["token_id"], token.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTFETeamTokenDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_team_token" {
@@ -183,3 +281,25 @@ resource "tfe_team_token" "regenerated" {
   team_id = "${tfe_team.foobar.id}"
   force_regenerate = true
 }`
+
+func testAccTFETeamToken_keepers(rotation string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_team" "foobar" {
+  name = "team-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_team_token" "foobar" {
+  team_id = "${tfe_team.foobar.id}"
+  rotate_after = "90d"
+
+  keepers = {
+    rotation = "%s"
+  }
+}`, rotation)
+}
@@ -63,7 +63,9 @@ func resourceTFEVariable() *schema.Resource {
 }
 
 func resourceTFEVariableCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get key, category, workspace and organization.
 	key := d.Get("key").(string)
@@ -99,7 +101,9 @@ func resourceTFEVariableCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFEVariableRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Get workspace and organization.
 	workspace, organization := unpackWorkspaceID(d.Get("workspace_id").(string))
@@ -150,7 +154,9 @@ func resourceTFEVariableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFEVariableUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	// Create a new options struct.
 	options := tfe.VariableUpdateOptions{
@@ -170,7 +176,9 @@ func resourceTFEVariableUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFEVariableDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
 
 	log.Printf("[DEBUG] Delete variable: %s", d.Id())
 	err := tfeClient.Variables.Delete(ctx, d.Id())
@@ -1,12 +1,20 @@
 package tfe
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"regexp"
 	"strings"
+	"time"
 
 	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceTFEWorkspace() *schema.Resource {
@@ -16,6 +24,15 @@ func resourceTFEWorkspace() *schema.Resource {
 		Update: resourceTFEWorkspaceUpdate,
 		Delete: resourceTFEWorkspaceDelete,
 
+		CustomizeDiff: customizeDiffOrganization,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -24,16 +41,76 @@ func resourceTFEWorkspace() *schema.Resource {
 
 			"organization": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"auto_apply": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
 
+			"queue_all_runs": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"file_triggers_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"trigger_prefixes": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"speculative_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_destroy_plan": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"operations": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"global_remote_state": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"force_delete": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"destroy_on_delete": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"terraform_version": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -46,6 +123,24 @@ func resourceTFEWorkspace() *schema.Resource {
 				Computed: true,
 			},
 
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tag_names": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[a-z0-9][a-z0-9:_-]*$`),
+						"must only contain lowercase letters, numbers, colons, hyphens and underscores",
+					),
+				},
+			},
+
 			"vcs_repo": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -74,6 +169,11 @@ func resourceTFEWorkspace() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+
+						"tags_regex": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -82,7 +182,10 @@ func resourceTFEWorkspace() *schema.Resource {
 }
 
 func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutCreate)
+	defer cancel()
 
 	// Get the name and organization.
 	name := d.Get("name").(string)
@@ -90,7 +193,14 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Create a new options struct.
 	options := tfe.WorkspaceCreateOptions{
-		Name: tfe.String(name),
+		Name:                tfe.String(name),
+		Description:         tfe.String(d.Get("description").(string)),
+		QueueAllRuns:        tfe.Bool(d.Get("queue_all_runs").(bool)),
+		FileTriggersEnabled: tfe.Bool(d.Get("file_triggers_enabled").(bool)),
+		SpeculativeEnabled:  tfe.Bool(d.Get("speculative_enabled").(bool)),
+		AllowDestroyPlan:    tfe.Bool(d.Get("allow_destroy_plan").(bool)),
+		Operations:          tfe.Bool(d.Get("operations").(bool)),
+		TriggerPrefixes:     []string{},
 	}
 
 	// Process all configured options.
@@ -106,6 +216,22 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 		options.WorkingDirectory = tfe.String(workingDir.(string))
 	}
 
+	for _, prefix := range d.Get("trigger_prefixes").([]interface{}) {
+		options.TriggerPrefixes = append(options.TriggerPrefixes, prefix.(string))
+	}
+
+	if projectID, ok := d.GetOk("project_id"); ok {
+		options.Project = &tfe.Project{ID: projectID.(string)}
+	}
+
+	if globalRemoteState, ok := d.GetOkExists("global_remote_state"); ok {
+		options.GlobalRemoteState = tfe.Bool(globalRemoteState.(bool))
+	}
+
+	for _, tagName := range d.Get("tag_names").(*schema.Set).List() {
+		options.Tags = append(options.Tags, &tfe.Tag{Name: tagName.(string)})
+	}
+
 	// Get and assert the VCS repo configuration block.
 	if v, ok := d.GetOk("vcs_repo"); ok {
 		vcsRepo := v.(*schema.Set).List()[0].(map[string]interface{})
@@ -115,6 +241,7 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 			Branch:            tfe.String(vcsRepo["branch"].(string)),
 			IngressSubmodules: tfe.Bool(vcsRepo["ingress_submodules"].(bool)),
 			OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
+			TagsRegex:         tfe.String(vcsRepo["tags_regex"].(string)),
 		}
 	}
 
@@ -131,7 +258,10 @@ func resourceTFEWorkspaceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutRead)
+	defer cancel()
 
 	// Get the name and organization.
 	name, organization := unpackWorkspaceID(d.Id())
@@ -149,10 +279,24 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Update the config.
 	d.Set("name", workspace.Name)
+	d.Set("description", workspace.Description)
 	d.Set("auto_apply", workspace.AutoApply)
+	d.Set("queue_all_runs", workspace.QueueAllRuns)
+	d.Set("file_triggers_enabled", workspace.FileTriggersEnabled)
+	d.Set("trigger_prefixes", workspace.TriggerPrefixes)
+	d.Set("speculative_enabled", workspace.SpeculativeEnabled)
+	d.Set("allow_destroy_plan", workspace.AllowDestroyPlan)
+	d.Set("operations", workspace.Operations)
+	d.Set("global_remote_state", workspace.GlobalRemoteState)
 	d.Set("terraform_version", workspace.TerraformVersion)
 	d.Set("working_directory", workspace.WorkingDirectory)
 
+	if workspace.Project != nil {
+		d.Set("project_id", workspace.Project.ID)
+	}
+
+	d.Set("tag_names", workspace.TagNames)
+
 	var vcsRepo []interface{}
 	if workspace.VCSRepo != nil {
 		vcsRepo = append(vcsRepo, map[string]interface{}{
@@ -160,6 +304,7 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 			"branch":             workspace.VCSRepo.Branch,
 			"ingress_submodules": workspace.VCSRepo.IngressSubmodules,
 			"oauth_token_id":     workspace.VCSRepo.OAuthTokenID,
+			"tags_regex":         workspace.VCSRepo.TagsRegex,
 		})
 	}
 	d.Set("vcs_repo", vcsRepo)
@@ -168,14 +313,24 @@ func resourceTFEWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutUpdate)
+	defer cancel()
 
 	// Get the name and organization.
 	name, organization := unpackWorkspaceID(d.Id())
 
 	// Create a new options struct.
 	options := tfe.WorkspaceUpdateOptions{
-		Name: tfe.String(d.Get("name").(string)),
+		Name:                tfe.String(d.Get("name").(string)),
+		Description:         tfe.String(d.Get("description").(string)),
+		QueueAllRuns:        tfe.Bool(d.Get("queue_all_runs").(bool)),
+		FileTriggersEnabled: tfe.Bool(d.Get("file_triggers_enabled").(bool)),
+		SpeculativeEnabled:  tfe.Bool(d.Get("speculative_enabled").(bool)),
+		AllowDestroyPlan:    tfe.Bool(d.Get("allow_destroy_plan").(bool)),
+		Operations:          tfe.Bool(d.Get("operations").(bool)),
+		TriggerPrefixes:     []string{},
 	}
 
 	// Process all configured options.
@@ -191,6 +346,21 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 		options.WorkingDirectory = tfe.String(workingDir.(string))
 	}
 
+	for _, prefix := range d.Get("trigger_prefixes").([]interface{}) {
+		options.TriggerPrefixes = append(options.TriggerPrefixes, prefix.(string))
+	}
+
+	if d.HasChange("global_remote_state") {
+		options.GlobalRemoteState = tfe.Bool(d.Get("global_remote_state").(bool))
+	}
+
+	// Moving a workspace to another project is done in-place.
+	if d.HasChange("project_id") {
+		if projectID, ok := d.GetOk("project_id"); ok {
+			options.Project = &tfe.Project{ID: projectID.(string)}
+		}
+	}
+
 	// Get and assert the VCS repo configuration block.
 	if v, ok := d.GetOk("vcs_repo"); ok {
 		vcsRepo := v.(*schema.Set).List()[0].(map[string]interface{})
@@ -200,6 +370,7 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			Branch:            tfe.String(vcsRepo["branch"].(string)),
 			IngressSubmodules: tfe.Bool(vcsRepo["ingress_submodules"].(bool)),
 			OAuthTokenID:      tfe.String(vcsRepo["oauth_token_id"].(string)),
+			TagsRegex:         tfe.String(vcsRepo["tags_regex"].(string)),
 		}
 	}
 
@@ -210,17 +381,235 @@ func resourceTFEWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error
 			"Error updating workspace %s for organization %s: %v", name, organization, err)
 	}
 
+	// Tags are managed through the tags relationship of the workspace, so
+	// only the tags that were added or removed need to be sent.
+	if d.HasChange("tag_names") {
+		o, n := d.GetChange("tag_names")
+		oldTags := o.(*schema.Set)
+		newTags := n.(*schema.Set)
+
+		if removed := oldTags.Difference(newTags).List(); len(removed) > 0 {
+			options := tfe.WorkspaceRemoveTagsOptions{}
+			for _, tagName := range removed {
+				options.Tags = append(options.Tags, &tfe.Tag{Name: tagName.(string)})
+			}
+
+			log.Printf("[DEBUG] Remove tags from workspace: %s", workspace.ID)
+			if err := tfeClient.Workspaces.RemoveTags(ctx, workspace.ID, options); err != nil {
+				return fmt.Errorf("Error removing tags from workspace %s: %v", workspace.Name, err)
+			}
+		}
+
+		if added := newTags.Difference(oldTags).List(); len(added) > 0 {
+			options := tfe.WorkspaceAddTagsOptions{}
+			for _, tagName := range added {
+				options.Tags = append(options.Tags, &tfe.Tag{Name: tagName.(string)})
+			}
+
+			log.Printf("[DEBUG] Add tags to workspace: %s", workspace.ID)
+			if err := tfeClient.Workspaces.AddTags(ctx, workspace.ID, options); err != nil {
+				return fmt.Errorf("Error adding tags to workspace %s: %v", workspace.Name, err)
+			}
+		}
+	}
+
 	d.SetId(packWorkspaceID(workspace))
 
 	return resourceTFEWorkspaceRead(d, meta)
 }
 
 func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
-	tfeClient := meta.(*tfe.Client)
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutDelete)
+	defer cancel()
 
 	// Get the name and organization.
 	name, organization := unpackWorkspaceID(d.Id())
 
+	if d.Get("destroy_on_delete").(bool) {
+		workspace, err := tfeClient.Workspaces.Read(ctx, organization, name)
+		if err != nil {
+			if err == tfe.ErrResourceNotFound {
+				return nil
+			}
+			return fmt.Errorf("Error reading configuration of workspace %s: %v", name, err)
+		}
+
+		if err := destroyWorkspaceResources(ctx, tfeClient, workspace, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("force_delete").(bool) {
+		return deleteWorkspace(ctx, tfeClient, organization, name)
+	}
+
+	log.Printf("[DEBUG] Safe delete workspace %s from organization: %s", name, organization)
+	err := tfeClient.Workspaces.SafeDelete(ctx, organization, name)
+	switch err {
+	case nil:
+		return nil
+	case tfe.ErrWorkspaceNotSafeToDelete:
+		return fmt.Errorf(
+			"Workspace %s still manages resources, destroy them first or set "+
+				"force_delete to true to delete the workspace anyway", name)
+	case tfe.ErrResourceNotFound:
+		// Either the workspace no longer exists, or this version of Terraform
+		// Enterprise does not support safe deletes. Both are handled below.
+	default:
+		return fmt.Errorf(
+			"Error deleting workspace %s from organization %s: %v", name, organization, err)
+	}
+
+	workspace, err := tfeClient.Workspaces.Read(ctx, organization, name)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of workspace %s: %v", name, err)
+	}
+
+	log.Printf("[DEBUG] Safe delete is not supported, inspecting the state of workspace: %s", name)
+	managesResources, err := workspaceManagesResources(ctx, tfeClient, workspace)
+	if err != nil {
+		return fmt.Errorf("Error inspecting the state of workspace %s: %v", name, err)
+	}
+
+	if managesResources {
+		return fmt.Errorf(
+			"Workspace %s still manages resources, destroy them first or set "+
+				"force_delete to true to delete the workspace anyway", name)
+	}
+
+	return deleteWorkspace(ctx, tfeClient, organization, name)
+}
+
+// destroyWorkspaceResources queues a destroy run on the workspace and waits
+// until it is applied. Runs that need confirmation are applied by us, while
+// failed runs return an error containing the logs of the failed stage.
+func destroyWorkspaceResources(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace, timeout time.Duration) error {
+	options := tfe.RunCreateOptions{
+		IsDestroy: tfe.Bool(true),
+		Message:   tfe.String("Queued by Terraform to destroy all resources before deleting the workspace"),
+		Workspace: workspace,
+	}
+
+	log.Printf("[DEBUG] Queue destroy run for workspace: %s", workspace.Name)
+	run, err := tfeClient.Runs.Create(ctx, options)
+	if err != nil {
+		return fmt.Errorf("Error queueing destroy run for workspace %s: %v", workspace.Name, err)
+	}
+
+	confirmed := false
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"done"},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Read status of destroy run: %s", run.ID)
+			r, err := tfeClient.Runs.Read(ctx, run.ID)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error reading destroy run %s: %v", run.ID, err)
+			}
+
+			state, err := destroyRunState(r, workspace)
+			if err != nil {
+				return nil, "", err
+			}
+
+			switch state {
+			case "confirmable":
+				if !confirmed {
+					log.Printf("[DEBUG] Apply destroy run: %s", run.ID)
+					err := tfeClient.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{
+						Comment: tfe.String("Applied by Terraform to delete the workspace"),
+					})
+					if err != nil {
+						return nil, "", fmt.Errorf("Error applying destroy run %s: %v", run.ID, err)
+					}
+					confirmed = true
+				}
+				return r, "pending", nil
+			case "errored":
+				return nil, "", fmt.Errorf(
+					"Destroy run %s of workspace %s errored:\n\n%s",
+					run.ID, workspace.Name, runLogs(ctx, tfeClient, r))
+			}
+
+			return r, state, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// destroyRunState returns the state of a destroy run: "done" when it is
+// applied or there was nothing to destroy, "confirmable" when it waits to be
+// applied by us, "errored" when it failed and "pending" otherwise. Runs that
+// can't complete without manual intervention return an error.
+func destroyRunState(run *tfe.Run, workspace *tfe.Workspace) (string, error) {
+	switch run.Status {
+	case tfe.RunApplied, tfe.RunPlannedAndFinished:
+		return "done", nil
+	case tfe.RunPlanned, tfe.RunCostEstimated, tfe.RunPolicyChecked:
+		// Runs of workspaces with auto apply are applied without confirmation.
+		if run.Actions != nil && run.Actions.IsConfirmable {
+			return "confirmable", nil
+		}
+		return "pending", nil
+	case tfe.RunErrored:
+		return "errored", nil
+	case tfe.RunPolicyOverride:
+		return "", fmt.Errorf(
+			"Destroy run %s of workspace %s failed a policy check and needs a policy "+
+				"override: override the policy check and apply the run manually, or set "+
+				"destroy_on_delete to false", run.ID, workspace.Name)
+	case tfe.RunCanceled, tfe.RunDiscarded, tfe.RunPolicySoftFailed:
+		return "", fmt.Errorf(
+			"Destroy run %s of workspace %s did not complete: %s", run.ID, workspace.Name, run.Status)
+	default:
+		return "pending", nil
+	}
+}
+
+// runLogs returns the logs of the stage in which the run failed. Any errors
+// retrieving the logs are included in the returned text.
+func runLogs(ctx context.Context, tfeClient *tfe.Client, run *tfe.Run) string {
+	if run.Plan == nil {
+		return "no logs available"
+	}
+
+	plan, err := tfeClient.Plans.Read(ctx, run.Plan.ID)
+	if err != nil {
+		return fmt.Sprintf("failed to read plan %s: %v", run.Plan.ID, err)
+	}
+
+	// Only get the apply logs if the plan finished successfully.
+	var logs io.Reader
+	if plan.Status == tfe.PlanFinished && run.Apply != nil {
+		logs, err = tfeClient.Applies.Logs(ctx, run.Apply.ID)
+	} else {
+		logs, err = tfeClient.Plans.Logs(ctx, plan.ID)
+	}
+	if err != nil {
+		return fmt.Sprintf("failed to retrieve logs: %v", err)
+	}
+
+	b, err := ioutil.ReadAll(logs)
+	if err != nil {
+		return fmt.Sprintf("failed to read logs: %v", err)
+	}
+
+	return string(b)
+}
+
+func deleteWorkspace(ctx context.Context, tfeClient *tfe.Client, organization, name string) error {
 	log.Printf("[DEBUG] Delete workspace %s from organization: %s", name, organization)
 	err := tfeClient.Workspaces.Delete(ctx, organization, name)
 	if err != nil {
@@ -234,6 +623,70 @@ func resourceTFEWorkspaceDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// workspaceManagesResources downloads the current state of the workspace
+// and reports whether it contains any managed resources.
+func workspaceManagesResources(ctx context.Context, tfeClient *tfe.Client, workspace *tfe.Workspace) (bool, error) {
+	sv, err := tfeClient.StateVersions.Current(ctx, workspace.ID)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			// A workspace without state does not manage any resources.
+			return false, nil
+		}
+		return false, err
+	}
+
+	state, err := tfeClient.StateVersions.Download(ctx, sv.DownloadURL)
+	if err != nil {
+		return false, err
+	}
+
+	return stateHasResources(state)
+}
+
+// stateHasResources reports whether the given raw Terraform state contains
+// any managed resources. Both the version 3 format written by Terraform 0.11
+// and earlier and the version 4 format written by newer versions are
+// supported.
+func stateHasResources(raw []byte) (bool, error) {
+	var state struct {
+		Version int `json:"version"`
+
+		// Version 3 and earlier.
+		Modules []struct {
+			Resources map[string]json.RawMessage `json:"resources"`
+		} `json:"modules"`
+
+		// Version 4 and later.
+		Resources []struct {
+			Mode      string            `json:"mode"`
+			Instances []json.RawMessage `json:"instances"`
+		} `json:"resources"`
+	}
+
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return false, err
+	}
+
+	if state.Version < 4 {
+		for _, module := range state.Modules {
+			for key := range module.Resources {
+				if !strings.HasPrefix(key, "data.") {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+
+	for _, r := range state.Resources {
+		if r.Mode == "managed" && len(r.Instances) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func packWorkspaceID(w *tfe.Workspace) string {
 	return w.Name + "|" + w.Organization.Name
 }
@@ -242,3 +695,14 @@ func unpackWorkspaceID(id string) (name, organization string) {
 	s := strings.SplitN(id, "|", 2)
 	return s[0], s[1]
 }
+
+// validateWorkspaceID makes sure a workspace ID can be unpacked, as any other
+// value would only fail when applying.
+func validateWorkspaceID(v interface{}, k string) (ws []string, errs []error) {
+	s := strings.SplitN(v.(string), "|", 2)
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		errs = append(errs, fmt.Errorf(
+			"%q must be the ID of a tfe_workspace, formatted as \"<name>|<organization>\", got: %q", k, v))
+	}
+	return
+}
@@ -0,0 +1,199 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceTFEWorkspaceRemoteStateConsumers() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEWorkspaceRemoteStateConsumersCreate,
+		Read:   resourceTFEWorkspaceRemoteStateConsumersRead,
+		Update: resourceTFEWorkspaceRemoteStateConsumersUpdate,
+		Delete: resourceTFEWorkspaceRemoteStateConsumersDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWorkspaceID,
+			},
+
+			"consumer_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateWorkspaceID,
+				},
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutCreate)
+	defer cancel()
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Get("workspace_id").(string))
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	if err := updateRemoteStateConsumers(ctx, tfeClient, ws, d.Get("consumer_ids").(*schema.Set)); err != nil {
+		return err
+	}
+
+	d.SetId(packWorkspaceID(ws))
+
+	return resourceTFEWorkspaceRemoteStateConsumersRead(d, meta)
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutRead)
+	defer cancel()
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Id())
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace %s does no longer exist", workspace)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	// Create a new options struct.
+	options := tfe.RemoteStateConsumersListOptions{
+		ListOptions: tfe.ListOptions{PageNumber: 1, PageSize: 100},
+	}
+
+	var consumerIDs []interface{}
+	for {
+		log.Printf("[DEBUG] List remote state consumers of workspace: %s", ws.ID)
+		consumers, err := tfeClient.Workspaces.RemoteStateConsumers(ctx, ws.ID, options)
+		if err != nil {
+			return fmt.Errorf(
+				"Error listing remote state consumers of workspace %s: %v", workspace, err)
+		}
+
+		for _, consumer := range consumers {
+			consumerIDs = append(consumerIDs, packWorkspaceID(consumer))
+		}
+
+		// Stop when we received less results than requested.
+		if len(consumers) < options.PageSize {
+			break
+		}
+		options.PageNumber++
+	}
+
+	// Update the config.
+	d.Set("workspace_id", d.Id())
+	d.Set("consumer_ids", consumerIDs)
+
+	return nil
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutUpdate)
+	defer cancel()
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Id())
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	if err := updateRemoteStateConsumers(ctx, tfeClient, ws, d.Get("consumer_ids").(*schema.Set)); err != nil {
+		return err
+	}
+
+	return resourceTFEWorkspaceRemoteStateConsumersRead(d, meta)
+}
+
+func resourceTFEWorkspaceRemoteStateConsumersDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx, cancel := config.contextWithTimeout(d, schema.TimeoutDelete)
+	defer cancel()
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Id())
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	// Removing all consumers leaves the workspace without any remote state
+	// sharing, unless global remote state is enabled.
+	return updateRemoteStateConsumers(ctx, tfeClient, ws, &schema.Set{F: schema.HashString})
+}
+
+// updateRemoteStateConsumers replaces the remote state consumers of the
+// given workspace with the workspaces in consumerIDs.
+func updateRemoteStateConsumers(ctx context.Context, tfeClient *tfe.Client, ws *tfe.Workspace, consumerIDs *schema.Set) error {
+	options := tfe.WorkspaceUpdateRemoteStateConsumersOptions{
+		Workspaces: []*tfe.Workspace{},
+	}
+
+	for _, consumerID := range consumerIDs.List() {
+		name, organization := unpackWorkspaceID(consumerID.(string))
+
+		consumer, err := tfeClient.Workspaces.Read(ctx, organization, name)
+		if err != nil {
+			return fmt.Errorf(
+				"Error retrieving workspace %s from organization %s: %v", name, organization, err)
+		}
+
+		options.Workspaces = append(options.Workspaces, &tfe.Workspace{ID: consumer.ID})
+	}
+
+	log.Printf("[DEBUG] Update remote state consumers of workspace: %s", ws.ID)
+	err := tfeClient.Workspaces.UpdateRemoteStateConsumers(ctx, ws.ID, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error updating remote state consumers of workspace %s: %v", ws.Name, err)
+	}
+
+	return nil
+}
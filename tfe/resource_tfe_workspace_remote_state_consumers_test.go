@@ -0,0 +1,186 @@
+package tfe
+
+import (
+	"fmt"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestWorkspaceRemoteStateConsumers_invalidIDs(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"workspace": {
+			"workspace_id": "ws-6jrRyVDv1J8zQMB5",
+			"consumer_ids": []interface{}{"workspace-consumer|terraform-test"},
+		},
+		"consumer": {
+			"workspace_id": "workspace-test|terraform-test",
+			"consumer_ids": []interface{}{"ws-6jrRyVDv1J8zQMB5"},
+		},
+	}
+
+	for name, raw := range cases {
+		c, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		_, errs := resourceTFEWorkspaceRemoteStateConsumers().Validate(terraform.NewResourceConfig(c))
+		if len(errs) != 1 {
+			t.Fatalf("%s: expected 1 error, got: %v", name, errs)
+		}
+	}
+}
+
+func TestAccTFEWorkspaceRemoteStateConsumers_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRemoteStateConsumersDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEWorkspaceRemoteStateConsumers_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceRemoteStateConsumersCount(
+						"tfe_workspace_remote_state_consumers.foobar", 1),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_remote_state_consumers.foobar", "consumer_ids.#", "1"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEWorkspaceRemoteStateConsumers_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceRemoteStateConsumersCount(
+						"tfe_workspace_remote_state_consumers.foobar", 2),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_remote_state_consumers.foobar", "consumer_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspaceRemoteStateConsumersCount(
+	n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		// Get the name and organization.
+		name, organization := unpackWorkspaceID(rs.Primary.ID)
+
+		ws, err := tfeClient.Workspaces.Read(ctx, organization, name)
+		if err != nil {
+			return err
+		}
+
+		consumers, err := tfeClient.Workspaces.RemoteStateConsumers(
+			ctx, ws.ID, tfe.RemoteStateConsumersListOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(consumers) != count {
+			return fmt.Errorf("Bad number of remote state consumers: %d", len(consumers))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceRemoteStateConsumersDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_remote_state_consumers" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		// Get the name and organization.
+		name, organization := unpackWorkspaceID(rs.Primary.ID)
+
+		ws, err := tfeClient.Workspaces.Read(ctx, organization, name)
+		if err != nil {
+			// The workspace is gone, so are its consumers.
+			continue
+		}
+
+		consumers, err := tfeClient.Workspaces.RemoteStateConsumers(
+			ctx, ws.ID, tfe.RemoteStateConsumersListOptions{})
+		if err == nil && len(consumers) > 0 {
+			return fmt.Errorf("Remote state consumers of %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+const testAccTFEWorkspaceRemoteStateConsumers_basic = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "network" {
+  name = "workspace-network"
+  organization = "${tfe_organization.foobar.id}"
+  global_remote_state = false
+}
+
+resource "tfe_workspace" "app" {
+  name = "workspace-app"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_workspace" "db" {
+  name = "workspace-db"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_workspace_remote_state_consumers" "foobar" {
+  workspace_id = "${tfe_workspace.network.id}"
+  consumer_ids = ["${tfe_workspace.app.id}"]
+}`
+
+const testAccTFEWorkspaceRemoteStateConsumers_update = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "network" {
+  name = "workspace-network"
+  organization = "${tfe_organization.foobar.id}"
+  global_remote_state = false
+}
+
+resource "tfe_workspace" "app" {
+  name = "workspace-app"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_workspace" "db" {
+  name = "workspace-db"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_workspace_remote_state_consumers" "foobar" {
+  workspace_id = "${tfe_workspace.network.id}"
+  consumer_ids = ["${tfe_workspace.app.id}", "${tfe_workspace.db.id}"]
+}`
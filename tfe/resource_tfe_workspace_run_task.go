@@ -0,0 +1,205 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceTFEWorkspaceRunTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTFEWorkspaceRunTaskCreate,
+		Read:   resourceTFEWorkspaceRunTaskRead,
+		Update: resourceTFEWorkspaceRunTaskUpdate,
+		Delete: resourceTFEWorkspaceRunTaskDelete,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWorkspaceID,
+			},
+
+			"task_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enforcement_level": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.Advisory),
+						string(tfe.Mandatory),
+					},
+					false,
+				),
+			},
+
+			"stage": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(tfe.PostPlan),
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						string(tfe.PrePlan),
+						string(tfe.PostPlan),
+						string(tfe.PreApply),
+					},
+					false,
+				),
+			},
+		},
+	}
+}
+
+func resourceTFEWorkspaceRunTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Get("workspace_id").(string))
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	// Get the run task.
+	taskID := d.Get("task_id").(string)
+	task, err := tfeClient.RunTasks.Read(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving run task %s: %v", taskID, err)
+	}
+
+	// Create a new options struct.
+	options := tfe.WorkspaceRunTaskCreateOptions{
+		EnforcementLevel: tfe.TaskEnforcementLevel(d.Get("enforcement_level").(string)),
+		Stage:            tfe.TaskStage(tfe.Stage(d.Get("stage").(string))),
+		RunTask:          task,
+	}
+
+	log.Printf("[DEBUG] Attach run task %s to workspace: %s", task.Name, ws.ID)
+	wsTask, err := tfeClient.WorkspaceRunTasks.Create(ctx, ws.ID, options)
+	if err != nil {
+		return fmt.Errorf(
+			"Error attaching run task %s to workspace %s: %v", task.Name, workspace, err)
+	}
+
+	d.SetId(wsTask.ID)
+
+	return resourceTFEWorkspaceRunTaskRead(d, meta)
+}
+
+func resourceTFEWorkspaceRunTaskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Get("workspace_id").(string))
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace %s does no longer exist", workspace)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	log.Printf("[DEBUG] Read configuration of workspace run task: %s", d.Id())
+	wsTask, err := tfeClient.WorkspaceRunTasks.Read(ctx, ws.ID, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			log.Printf("[DEBUG] Workspace run task %s does no longer exist", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading configuration of workspace run task %s: %v", d.Id(), err)
+	}
+
+	// Update the config.
+	d.Set("enforcement_level", string(wsTask.EnforcementLevel))
+	d.Set("stage", string(wsTask.Stage))
+
+	if wsTask.RunTask != nil {
+		d.Set("task_id", wsTask.RunTask.ID)
+	} else {
+		d.Set("task_id", "")
+	}
+
+	return nil
+}
+
+func resourceTFEWorkspaceRunTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Get("workspace_id").(string))
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	// Create a new options struct.
+	options := tfe.WorkspaceRunTaskUpdateOptions{
+		EnforcementLevel: tfe.TaskEnforcementLevel(d.Get("enforcement_level").(string)),
+		Stage:            tfe.TaskStage(tfe.Stage(d.Get("stage").(string))),
+	}
+
+	log.Printf("[DEBUG] Update workspace run task: %s", d.Id())
+	_, err = tfeClient.WorkspaceRunTasks.Update(ctx, ws.ID, d.Id(), options)
+	if err != nil {
+		return fmt.Errorf("Error updating workspace run task %s: %v", d.Id(), err)
+	}
+
+	return resourceTFEWorkspaceRunTaskRead(d, meta)
+}
+
+func resourceTFEWorkspaceRunTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*ConfiguredClient)
+	tfeClient := config.Client
+	ctx := config.StopContext
+
+	// Get the workspace and organization.
+	workspace, organization := unpackWorkspaceID(d.Get("workspace_id").(string))
+
+	// Get the workspace.
+	ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf(
+			"Error retrieving workspace %s from organization %s: %v", workspace, organization, err)
+	}
+
+	log.Printf("[DEBUG] Delete workspace run task: %s", d.Id())
+	err = tfeClient.WorkspaceRunTasks.Delete(ctx, ws.ID, d.Id())
+	if err != nil {
+		if err == tfe.ErrResourceNotFound {
+			return nil
+		}
+		return fmt.Errorf("Error deleting workspace run task %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package tfe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestWorkspaceRunTask_invalidWorkspaceID(t *testing.T) {
+	c, err := config.NewRawConfig(map[string]interface{}{
+		"workspace_id":      "ws-6jrRyVDv1J8zQMB5",
+		"task_id":           "task-123",
+		"enforcement_level": "advisory",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, errs := resourceTFEWorkspaceRunTask().Validate(terraform.NewResourceConfig(c))
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got: %v", errs)
+	}
+}
+
+func TestAccTFEWorkspaceRunTask_basic(t *testing.T) {
+	wsTask := &tfe.WorkspaceRunTask{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckRunTask(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceRunTaskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEWorkspaceRunTask_basic("advisory", "post_plan"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceRunTaskExists(
+						"tfe_workspace_run_task.foobar", wsTask),
+					testAccCheckTFEWorkspaceRunTaskAttributes(wsTask, tfe.Advisory, tfe.PostPlan),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task.foobar", "enforcement_level", "advisory"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task.foobar", "stage", "post_plan"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEWorkspaceRunTask_basic("mandatory", "pre_apply"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceRunTaskExists(
+						"tfe_workspace_run_task.foobar", wsTask),
+					testAccCheckTFEWorkspaceRunTaskAttributes(wsTask, tfe.Mandatory, tfe.PreApply),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task.foobar", "enforcement_level", "mandatory"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace_run_task.foobar", "stage", "pre_apply"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTFEWorkspaceRunTaskExists(
+	n string, wsTask *tfe.WorkspaceRunTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		// Get the workspace and organization.
+		workspace, organization := unpackWorkspaceID(rs.Primary.Attributes["workspace_id"])
+
+		ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+		if err != nil {
+			return err
+		}
+
+		wrt, err := tfeClient.WorkspaceRunTasks.Read(ctx, ws.ID, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if wrt.ID != rs.Primary.ID {
+			return fmt.Errorf("Workspace run task not found")
+		}
+
+		*wsTask = *wrt
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceRunTaskAttributes(
+	wsTask *tfe.WorkspaceRunTask, level tfe.TaskEnforcementLevel, stage tfe.Stage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if wsTask.EnforcementLevel != level {
+			return fmt.Errorf("Bad enforcement level: %s", wsTask.EnforcementLevel)
+		}
+
+		if wsTask.Stage != stage {
+			return fmt.Errorf("Bad stage: %s", wsTask.Stage)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceRunTaskDestroy(s *terraform.State) error {
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "tfe_workspace_run_task" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No instance ID is set")
+		}
+
+		// Get the workspace and organization.
+		workspace, organization := unpackWorkspaceID(rs.Primary.Attributes["workspace_id"])
+
+		ws, err := tfeClient.Workspaces.Read(ctx, organization, workspace)
+		if err != nil {
+			// The workspace is gone, so is the workspace run task.
+			continue
+		}
+
+		_, err = tfeClient.WorkspaceRunTasks.Read(ctx, ws.ID, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Workspace run task %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccTFEWorkspaceRunTask_basic(level, stage string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name = "workspace-test"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_organization_run_task" "foobar" {
+  name = "run-task-test"
+  organization = "${tfe_organization.foobar.id}"
+  url = "%s"
+}
+
+resource "tfe_workspace_run_task" "foobar" {
+  workspace_id = "${tfe_workspace.foobar.id}"
+  task_id = "${tfe_organization_run_task.foobar.id}"
+  enforcement_level = "%s"
+  stage = "%s"
+}`, os.Getenv("TFE_RUN_TASK_URL"), level, stage)
+}
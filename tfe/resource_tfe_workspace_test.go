@@ -1,11 +1,16 @@
 package tfe
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	tfe "github.com/HappyPathway/go-tfe"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -29,6 +34,16 @@ func TestAccTFEWorkspace_basic(t *testing.T) {
 						"tfe_workspace.foobar", "auto_apply", "false"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "working_directory", ""),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "queue_all_runs", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "file_triggers_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "speculative_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "allow_destroy_plan", "true"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "operations", "true"),
 				),
 			},
 		},
@@ -72,16 +87,156 @@ func TestAccTFEWorkspace_update(t *testing.T) {
 						"tfe_workspace.foobar", "terraform_version", "0.11.1"),
 					resource.TestCheckResourceAttr(
 						"tfe_workspace.foobar", "working_directory", "terraform/test"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "description", "An updated workspace"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "queue_all_runs", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "file_triggers_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "trigger_prefixes.0", "/modules"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "speculative_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "allow_destroy_plan", "false"),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "operations", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_tags(t *testing.T) {
+	workspace := &tfe.Workspace{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEWorkspace_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.#", "2"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEWorkspace_tagsUpdated,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					testAccCheckTFEWorkspaceTagNames(workspace, []string{"env:prod", "team:platform"}),
+					resource.TestCheckResourceAttr(
+						"tfe_workspace.foobar", "tag_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTFEWorkspace_project(t *testing.T) {
+	workspace := &tfe.Workspace{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTFEWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccTFEWorkspace_project("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace.foobar", "project_id", "tfe_project.first", "id"),
+				),
+			},
+
+			resource.TestStep{
+				Config: testAccTFEWorkspace_project("second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTFEWorkspaceExists(
+						"tfe_workspace.foobar", workspace),
+					resource.TestCheckResourceAttrPair(
+						"tfe_workspace.foobar", "project_id", "tfe_project.second", "id"),
 				),
 			},
 		},
 	})
 }
 
+func TestStateHasResources(t *testing.T) {
+	cases := map[string]struct {
+		state string
+		want  bool
+	}{
+		"v3 empty": {
+			state: `{"version": 3, "modules": [{"path": ["root"], "resources": {}}]}`,
+			want:  false,
+		},
+		"v3 data sources only": {
+			state: `{"version": 3, "modules": [{"path": ["root"], "resources": {
+				"data.aws_ami.ubuntu": {"type": "aws_ami"}
+			}}]}`,
+			want: false,
+		},
+		"v3 managed resource in child module": {
+			state: `{"version": 3, "modules": [
+				{"path": ["root"], "resources": {}},
+				{"path": ["root", "vpc"], "resources": {"aws_vpc.main": {"type": "aws_vpc"}}}
+			]}`,
+			want: true,
+		},
+		"v4 empty": {
+			state: `{"version": 4, "resources": []}`,
+			want:  false,
+		},
+		"v4 data sources only": {
+			state: `{"version": 4, "resources": [
+				{"mode": "data", "type": "aws_ami", "instances": [{}]}
+			]}`,
+			want: false,
+		},
+		"v4 managed resource without instances": {
+			state: `{"version": 4, "resources": [
+				{"mode": "managed", "type": "aws_instance", "instances": []}
+			]}`,
+			want: false,
+		},
+		"v4 managed resource": {
+			state: `{"version": 4, "resources": [
+				{"mode": "managed", "type": "aws_instance", "instances": [{}]}
+			]}`,
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		got, err := stateHasResources([]byte(tc.state))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if got != tc.want {
+			t.Fatalf("%s: expected %t, got %t", name, tc.want, got)
+		}
+	}
+
+	if _, err := stateHasResources([]byte("not json")); err == nil {
+		t.Fatal("expected an error for invalid state")
+	}
+}
+
 func testAccCheckTFEWorkspaceExists(
 	n string, workspace *tfe.Workspace) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		tfeClient := testAccProvider.Meta().(*tfe.Client)
+		tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -148,12 +303,48 @@ func testAccCheckTFEWorkspaceAttributesUpdated(
 			return fmt.Errorf("Bad working directory: %s", workspace.WorkingDirectory)
 		}
 
+		if workspace.Description != "An updated workspace" {
+			return fmt.Errorf("Bad description: %s", workspace.Description)
+		}
+
+		if workspace.QueueAllRuns != false {
+			return fmt.Errorf("Bad queue all runs: %t", workspace.QueueAllRuns)
+		}
+
+		if workspace.Operations != false {
+			return fmt.Errorf("Bad operations: %t", workspace.Operations)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTFEWorkspaceTagNames(
+	workspace *tfe.Workspace, tagNames []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(workspace.TagNames) != len(tagNames) {
+			return fmt.Errorf("Bad tag names: %v", workspace.TagNames)
+		}
+
+		for _, want := range tagNames {
+			found := false
+			for _, tagName := range workspace.TagNames {
+				if tagName == want {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("Missing tag name %s: %v", want, workspace.TagNames)
+			}
+		}
+
 		return nil
 	}
 }
 
 func testAccCheckTFEWorkspaceDestroy(s *terraform.State) error {
-	tfeClient := testAccProvider.Meta().(*tfe.Client)
+	tfeClient := testAccProvider.Meta().(*ConfiguredClient).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "tfe_workspace" {
@@ -199,4 +390,294 @@ resource "tfe_workspace" "foobar" {
   auto_apply = true
   terraform_version = "0.11.1"
   working_directory = "terraform/test"
+  description = "An updated workspace"
+  queue_all_runs = false
+  file_triggers_enabled = false
+  trigger_prefixes = ["/modules", "/shared"]
+  speculative_enabled = false
+  allow_destroy_plan = false
+  operations = false
 }`
+
+const testAccTFEWorkspace_tags = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name = "workspace-test"
+  organization = "${tfe_organization.foobar.id}"
+  tag_names = ["team:payments", "env:prod"]
+}`
+
+const testAccTFEWorkspace_tagsUpdated = `
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_workspace" "foobar" {
+  name = "workspace-test"
+  organization = "${tfe_organization.foobar.id}"
+  tag_names = ["team:platform", "env:prod"]
+}`
+
+func testAccTFEWorkspace_project(project string) string {
+	return fmt.Sprintf(`
+resource "tfe_organization" "foobar" {
+  name = "terraform-test"
+  email = "admin@company.com"
+}
+
+resource "tfe_project" "first" {
+  name = "project-first"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_project" "second" {
+  name = "project-second"
+  organization = "${tfe_organization.foobar.id}"
+}
+
+resource "tfe_workspace" "foobar" {
+  name = "workspace-test"
+  organization = "${tfe_organization.foobar.id}"
+  project_id = "${tfe_project.%s.id}"
+}`, project)
+}
+
+func TestResourceTFEWorkspaceDelete(t *testing.T) {
+	cases := map[string]struct {
+		safeDeleteErr error
+		state         string
+		err           bool
+		deleted       bool
+	}{
+		"safe delete": {
+			safeDeleteErr: nil,
+		},
+		"safe delete refused": {
+			safeDeleteErr: tfe.ErrWorkspaceNotSafeToDelete,
+			err:           true,
+		},
+		"fallback state with resources": {
+			safeDeleteErr: tfe.ErrResourceNotFound,
+			state:         `{"version": 4, "resources": [{"mode": "managed", "instances": [{}]}]}`,
+			err:           true,
+		},
+		"fallback empty state": {
+			safeDeleteErr: tfe.ErrResourceNotFound,
+			state:         `{"version": 4, "resources": []}`,
+			deleted:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		workspaces := &testWorkspaces{safeDeleteErr: tc.safeDeleteErr}
+		tfeClient := &tfe.Client{}
+		tfeClient.Workspaces = workspaces
+		tfeClient.StateVersions = &testStateVersions{state: tc.state}
+
+		d := schema.TestResourceDataRaw(t, resourceTFEWorkspace().Schema, map[string]interface{}{
+			"name":         "workspace-test",
+			"organization": "terraform-test",
+		})
+		d.SetId("workspace-test|terraform-test")
+
+		err := resourceTFEWorkspaceDelete(d, &ConfiguredClient{Client: tfeClient, StopContext: ctx})
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error to be %t, got: %v", name, tc.err, err)
+		}
+		if workspaces.deleted != tc.deleted {
+			t.Fatalf("%s: expected deleted to be %t, got: %t", name, tc.deleted, workspaces.deleted)
+		}
+	}
+}
+
+func TestValidateWorkspaceID(t *testing.T) {
+	cases := map[string]bool{
+		"workspace-test|terraform-test": true,
+		"ws-6jrRyVDv1J8zQMB5":           false,
+		"workspace-test|":               false,
+		"|terraform-test":               false,
+		"":                              false,
+	}
+
+	for id, valid := range cases {
+		_, errs := validateWorkspaceID(id, "workspace_id")
+		if (len(errs) == 0) != valid {
+			t.Fatalf("%q: expected valid to be %t, got: %v", id, valid, errs)
+		}
+	}
+}
+
+func TestDestroyRunState(t *testing.T) {
+	workspace := &tfe.Workspace{Name: "workspace-test"}
+
+	cases := map[string]struct {
+		status      tfe.RunStatus
+		confirmable bool
+		state       string
+		err         bool
+	}{
+		"applied": {
+			status: tfe.RunApplied,
+			state:  "done",
+		},
+		"nothing to destroy": {
+			status: tfe.RunPlannedAndFinished,
+			state:  "done",
+		},
+		"planned": {
+			status:      tfe.RunPlanned,
+			confirmable: true,
+			state:       "confirmable",
+		},
+		"cost estimated": {
+			status:      tfe.RunCostEstimated,
+			confirmable: true,
+			state:       "confirmable",
+		},
+		"policy checked": {
+			status:      tfe.RunPolicyChecked,
+			confirmable: true,
+			state:       "confirmable",
+		},
+		"auto apply": {
+			status: tfe.RunPlanned,
+			state:  "pending",
+		},
+		"applying": {
+			status: tfe.RunApplying,
+			state:  "pending",
+		},
+		"errored": {
+			status: tfe.RunErrored,
+			state:  "errored",
+		},
+		"policy override": {
+			status: tfe.RunPolicyOverride,
+			err:    true,
+		},
+		"discarded": {
+			status: tfe.RunDiscarded,
+			err:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		run := &tfe.Run{
+			ID:      "run-123",
+			Status:  tc.status,
+			Actions: &tfe.RunActions{IsConfirmable: tc.confirmable},
+		}
+
+		state, err := destroyRunState(run, workspace)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error to be %t, got: %v", name, tc.err, err)
+		}
+		if state != tc.state {
+			t.Fatalf("%s: expected state %q, got %q", name, tc.state, state)
+		}
+	}
+}
+
+func TestRunLogs(t *testing.T) {
+	cases := map[string]struct {
+		run  *tfe.Run
+		plan *tfe.Plan
+		err  error
+		logs string
+	}{
+		"no plan": {
+			run:  &tfe.Run{},
+			logs: "no logs available",
+		},
+		"plan errored": {
+			run:  &tfe.Run{Plan: &tfe.Plan{ID: "plan-123"}, Apply: &tfe.Apply{ID: "apply-123"}},
+			plan: &tfe.Plan{ID: "plan-123", Status: tfe.PlanErrored},
+			logs: "plan logs",
+		},
+		"apply errored": {
+			run:  &tfe.Run{Plan: &tfe.Plan{ID: "plan-123"}, Apply: &tfe.Apply{ID: "apply-123"}},
+			plan: &tfe.Plan{ID: "plan-123", Status: tfe.PlanFinished},
+			logs: "apply logs",
+		},
+		"plan not readable": {
+			run:  &tfe.Run{Plan: &tfe.Plan{ID: "plan-123"}},
+			err:  errors.New("boom"),
+			logs: "failed to read plan plan-123: boom",
+		},
+	}
+
+	for name, tc := range cases {
+		tfeClient := &tfe.Client{}
+		tfeClient.Plans = &testPlans{plan: tc.plan, err: tc.err}
+		tfeClient.Applies = &testApplies{}
+
+		if logs := runLogs(ctx, tfeClient, tc.run); logs != tc.logs {
+			t.Fatalf("%s: expected logs %q, got %q", name, tc.logs, logs)
+		}
+	}
+}
+
+// testPlans implements the plan calls used by runLogs.
+type testPlans struct {
+	tfe.Plans
+	plan *tfe.Plan
+	err  error
+}
+
+func (p *testPlans) Read(ctx context.Context, planID string) (*tfe.Plan, error) {
+	return p.plan, p.err
+}
+
+func (p *testPlans) Logs(ctx context.Context, planID string) (io.Reader, error) {
+	return strings.NewReader("plan logs"), nil
+}
+
+// testApplies implements the apply calls used by runLogs.
+type testApplies struct {
+	tfe.Applies
+}
+
+func (a *testApplies) Logs(ctx context.Context, applyID string) (io.Reader, error) {
+	return strings.NewReader("apply logs"), nil
+}
+
+// testWorkspaces implements the workspace calls used when deleting a
+// workspace.
+type testWorkspaces struct {
+	tfe.Workspaces
+	safeDeleteErr error
+	deleted       bool
+}
+
+func (w *testWorkspaces) Read(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
+	return &tfe.Workspace{ID: "ws-123", Name: workspace}, nil
+}
+
+func (w *testWorkspaces) SafeDelete(ctx context.Context, organization, workspace string) error {
+	return w.safeDeleteErr
+}
+
+func (w *testWorkspaces) Delete(ctx context.Context, organization, workspace string) error {
+	w.deleted = true
+	return nil
+}
+
+// testStateVersions implements the state version calls used to inspect the
+// current state of a workspace.
+type testStateVersions struct {
+	tfe.StateVersions
+	state string
+}
+
+func (s *testStateVersions) Current(ctx context.Context, workspaceID string) (*tfe.StateVersion, error) {
+	return &tfe.StateVersion{ID: "sv-123", DownloadURL: "https://archivist.terraform.io/state"}, nil
+}
+
+func (s *testStateVersions) Download(ctx context.Context, url string) ([]byte, error) {
+	return []byte(s.state), nil
+}
@@ -0,0 +1,79 @@
+package tfe
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tlsConfig returns the TLS configuration used to connect to Terraform
+// Enterprise, for both service discovery and API calls.
+func tlsConfig(d *schema.ResourceData) (*tls.Config, error) {
+	cfg := &tls.Config{
+		InsecureSkipVerify: d.Get("ssl_skip_verify").(bool),
+	}
+
+	// Trust the configured CA certificates next to the system's CAs.
+	caCert, err := readPEM(d, "ca_cert_pem", "ca_cert_file")
+	if err != nil {
+		return nil, err
+	}
+	if len(caCert) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("Error parsing CA certificate: no valid certificates found")
+		}
+		cfg.RootCAs = pool
+	}
+
+	// Authenticate with a client certificate when configured.
+	clientCert, err := readPEM(d, "client_cert_pem", "client_cert_file")
+	if err != nil {
+		return nil, err
+	}
+	clientKey, err := readPEM(d, "client_key_pem", "client_key_file")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(clientCert) > 0 && len(clientKey) > 0:
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing client certificate: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	case len(clientCert) > 0:
+		return nil, fmt.Errorf("A client certificate requires a client key")
+	case len(clientKey) > 0:
+		return nil, fmt.Errorf("A client key requires a client certificate")
+	}
+
+	return cfg, nil
+}
+
+// readPEM returns the PEM encoded data configured inline or, when that is
+// not set, the content of the configured file.
+func readPEM(d *schema.ResourceData, pemKey, fileKey string) ([]byte, error) {
+	if data := d.Get(pemKey).(string); data != "" {
+		return []byte(data), nil
+	}
+
+	path := d.Get(fileKey).(string)
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading %s %s: %v", fileKey, path, err)
+	}
+
+	return data, nil
+}
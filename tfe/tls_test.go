@@ -0,0 +1,69 @@
+package tfe
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestTLSConfig(t *testing.T) {
+	caFile, err := ioutil.TempFile("", "ca")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(caFile.Name())
+	caFile.Close()
+
+	cases := map[string]struct {
+		raw map[string]interface{}
+		err string
+	}{
+		"defaults": {
+			raw: map[string]interface{}{},
+		},
+		"skip verify": {
+			raw: map[string]interface{}{"ssl_skip_verify": true},
+		},
+		"invalid CA certificate": {
+			raw: map[string]interface{}{"ca_cert_pem": "not a certificate"},
+			err: "no valid certificates found",
+		},
+		"empty CA certificate file": {
+			raw: map[string]interface{}{"ca_cert_file": caFile.Name()},
+		},
+		"missing CA certificate file": {
+			raw: map[string]interface{}{"ca_cert_file": caFile.Name() + ".missing"},
+			err: "Error reading ca_cert_file",
+		},
+		"client certificate without key": {
+			raw: map[string]interface{}{"client_cert_pem": "certificate"},
+			err: "requires a client key",
+		},
+		"client key without certificate": {
+			raw: map[string]interface{}{"client_key_pem": "key"},
+			err: "requires a client certificate",
+		},
+	}
+
+	for name, tc := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, tc.raw)
+
+		cfg, err := tlsConfig(d)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("%s: expected error containing %q, got: %v", name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if cfg.InsecureSkipVerify != d.Get("ssl_skip_verify").(bool) {
+			t.Fatalf("%s: bad InsecureSkipVerify: %t", name, cfg.InsecureSkipVerify)
+		}
+	}
+}
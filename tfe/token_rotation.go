@@ -0,0 +1,90 @@
+package tfe
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// parseRotateAfter parses a rotation window. Next to the durations accepted
+// by time.ParseDuration, a number of days like "90d" is accepted as well.
+func parseRotateAfter(v string) (time.Duration, error) {
+	if strings.HasSuffix(v, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(v, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid number of days: %s", v)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(v)
+}
+
+func validateRotateAfter(v interface{}, k string) (ws []string, errs []error) {
+	d, err := parseRotateAfter(v.(string))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a duration like \"2160h\" or \"90d\": %v", k, err))
+		return
+	}
+	if d <= 0 {
+		errs = append(errs, fmt.Errorf("%q must be a positive duration", k))
+	}
+	return
+}
+
+// customizeTokenRotationDiff marks the token for replacement once it is older
+// than the configured rotation window.
+func customizeTokenRotationDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Nothing to rotate when the token is not created yet.
+	if d.Id() == "" {
+		return nil
+	}
+
+	rotateAfter := d.Get("rotate_after").(string)
+	if rotateAfter == "" {
+		return nil
+	}
+
+	window, err := parseRotateAfter(rotateAfter)
+	if err != nil {
+		return err
+	}
+
+	// Without a known creation time there is nothing to compare against.
+	createdAt, err := time.Parse(time.RFC3339, d.Get("created_at").(string))
+	if err != nil {
+		return nil
+	}
+
+	if time.Since(createdAt) < window {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Token %s was created at %s and is due for rotation", d.Id(), createdAt)
+	if err := d.SetNewComputed("created_at"); err != nil {
+		return err
+	}
+
+	return d.ForceNew("created_at")
+}
+
+// customizeTokenRegenerationDiff marks the token for replacement when the
+// current token differs from the one generated by Terraform, which means the
+// token was regenerated outside of Terraform and the token in the state is no
+// longer valid.
+func customizeTokenRegenerationDiff(d *schema.ResourceDiff, tokenID string) error {
+	generatedID := d.Get("token_id").(string)
+	if generatedID == "" || generatedID == tokenID {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Token %s was regenerated outside of Terraform: %s", generatedID, tokenID)
+	if err := d.SetNewComputed("token_id"); err != nil {
+		return err
+	}
+
+	return d.ForceNew("token_id")
+}
@@ -0,0 +1,127 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tfe "github.com/HappyPathway/go-tfe"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseRotateAfter(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		duration time.Duration
+		err      bool
+	}{
+		"hours": {
+			value:    "2160h",
+			duration: 2160 * time.Hour,
+		},
+		"days": {
+			value:    "90d",
+			duration: 90 * 24 * time.Hour,
+		},
+		"invalid days": {
+			value: "ninetyd",
+			err:   true,
+		},
+		"invalid duration": {
+			value: "soon",
+			err:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		d, err := parseRotateAfter(tc.value)
+		if (err != nil) != tc.err {
+			t.Fatalf("%s: expected error %t, got: %v", name, tc.err, err)
+		}
+		if d != tc.duration {
+			t.Fatalf("%s: expected %s, got: %s", name, tc.duration, d)
+		}
+	}
+}
+
+func TestValidateRotateAfter(t *testing.T) {
+	if _, errs := validateRotateAfter("90d", "rotate_after"); len(errs) != 0 {
+		t.Fatalf("expected no errors, got: %v", errs)
+	}
+	if _, errs := validateRotateAfter("0h", "rotate_after"); len(errs) != 1 {
+		t.Fatalf("expected an error for a zero duration, got: %v", errs)
+	}
+}
+
+func TestCustomizeTokenRotationDiff(t *testing.T) {
+	cases := map[string]struct {
+		createdAt   time.Time
+		requiresNew bool
+	}{
+		"inside the rotation window": {
+			createdAt:   time.Now().Add(-24 * time.Hour),
+			requiresNew: false,
+		},
+		"older than the rotation window": {
+			createdAt:   time.Now().Add(-91 * 24 * time.Hour),
+			requiresNew: true,
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"team_id":      "team-123",
+		"rotate_after": "90d",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cfg := terraform.NewResourceConfig(raw)
+
+	tfeClient := &tfe.Client{}
+	tfeClient.TeamTokens = &testTeamTokens{tokenID: "at-123"}
+	meta := &ConfiguredClient{Client: tfeClient, StopContext: ctx}
+
+	for name, tc := range cases {
+		state := &terraform.InstanceState{
+			ID: "team-123",
+			Attributes: map[string]string{
+				"id":           "team-123",
+				"team_id":      "team-123",
+				"rotate_after": "90d",
+				"token":        "secret",
+				"token_id":     "at-123",
+				"created_at":   tc.createdAt.UTC().Format(time.RFC3339),
+			},
+		}
+
+		diff, err := resourceTFETeamToken().Diff(state, cfg, meta)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if tc.requiresNew {
+			if diff == nil || !diff.RequiresNew() {
+				t.Fatalf("%s: expected the token to be replaced, got: %#v", name, diff)
+			}
+			if attr, ok := diff.Attributes["created_at"]; !ok || !attr.RequiresNew {
+				t.Fatalf("%s: expected created_at to force a new token, got: %#v", name, attr)
+			}
+			continue
+		}
+
+		if diff != nil && !diff.Empty() {
+			t.Fatalf("%s: expected no diff, got: %#v", name, diff)
+		}
+	}
+}
+
+// testTeamTokens implements the team token calls used when planning.
+type testTeamTokens struct {
+	tfe.TeamTokens
+	tokenID string
+}
+
+func (t *testTeamTokens) Read(ctx context.Context, teamID string) (*tfe.TeamToken, error) {
+	return &tfe.TeamToken{ID: t.tokenID}, nil
+}
@@ -0,0 +1,194 @@
+package tfe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// auditEntry is a single line of the audit log. It deliberately contains no
+// request or response attributes, so no secrets end up in the audit log.
+// The address of the resource making the request is never known here, as
+// Terraform 0.11 only passes the resource type to providers.
+type auditEntry struct {
+	Time   string `json:"time"`
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Status int    `json:"status,omitempty"`
+	Type   string `json:"type,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// auditTransport is a http.RoundTripper that appends an entry to the audit
+// log for every request that creates, updates or deletes an object.
+type auditTransport struct {
+	transport http.RoundTripper
+	apiURL    *url.URL
+	log       *auditLog
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutating(req.Method) {
+		return t.transport.RoundTrip(req)
+	}
+
+	// Keep a copy of the request body to get the type of the object from.
+	var reqBody []byte
+	if req.Body != nil && isJSON(req.Header) {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r := req.WithContext(req.Context())
+		r.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(reqBody)), nil
+		}
+		req = r
+	}
+
+	entry := &auditEntry{
+		Time:   time.Now().UTC().Format(time.RFC3339),
+		Method: req.Method,
+		Path:   redactedPath(req, t.apiURL),
+	}
+	entry.Type, entry.ID = jsonAPIObject(reqBody)
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		entry.Error = err.Error()
+		t.log.write(entry)
+		return resp, err
+	}
+	entry.Status = resp.StatusCode
+
+	// The response contains the ID of a created object.
+	if isJSON(resp.Header) {
+		respBody, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+		if typ, id := jsonAPIObject(respBody); id != "" {
+			entry.Type, entry.ID = typ, id
+		}
+	}
+
+	// Deleted objects are only identified by the path.
+	if entry.ID == "" && req.Method == "DELETE" {
+		entry.ID = path.Base(entry.Path)
+	}
+
+	t.log.write(entry)
+
+	return resp, nil
+}
+
+// isMutating returns whether a request with the given method can create,
+// update or delete an object.
+func isMutating(method string) bool {
+	switch method {
+	case "POST", "PUT", "PATCH", "DELETE":
+		return true
+	}
+	return false
+}
+
+// isAPIRequest returns whether the request is sent to the API served on
+// apiURL, as opposed to e.g. a pre-signed upload or download URL.
+func isAPIRequest(req *http.Request, apiURL *url.URL) bool {
+	return req.URL.Host == apiURL.Host && strings.HasPrefix(req.URL.Path, apiURL.Path)
+}
+
+// redactedPath returns the path of the request to log. Uploads are sent to
+// URLs that contain a secret, so only the paths of API requests are logged.
+func redactedPath(req *http.Request, apiURL *url.URL) string {
+	if !isAPIRequest(req, apiURL) {
+		return redacted
+	}
+	return req.URL.Path
+}
+
+// jsonAPIObject returns the type and ID of the primary object of a JSON:API
+// document.
+func jsonAPIObject(body []byte) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+
+	var doc struct {
+		Data struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return "", ""
+	}
+
+	return doc.Data.Type, doc.Data.ID
+}
+
+// auditLog appends JSON encoded entries to a file.
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// write appends the entry to the audit log. The file is opened for every
+// entry, so multiple provider processes can safely append to the same file.
+func (l *auditLog) write(entry *auditEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[ERROR] Error encoding audit log entry: %v", err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := appendLine(l.path, line); err != nil {
+		log.Printf("[ERROR] Error writing audit log %s: %v", l.path, err)
+	}
+}
+
+// appendLine appends a line to the file at path, creating it if needed.
+func appendLine(path string, line []byte) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// checkAuditLog makes sure the audit log can be written, so a wrong path is
+// reported when configuring the provider instead of silently losing entries.
+func checkAuditLog(path string) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("Error opening audit log %s: %v", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,111 @@
+package tfe
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuditTransport(t *testing.T) {
+	for _, basePath := range []string{"/api/v2/", "/tfe/v2/"} {
+		testAuditTransport(t, basePath)
+	}
+}
+
+func testAuditTransport(t *testing.T, basePath string) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"data":{"type":"vars","id":"var-123","attributes":{"value":"secret"}}}`))
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "tfe-audit")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	apiURL, err := apiBaseURL(ts.URL, basePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	path := filepath.Join(dir, "audit.log")
+	client := &http.Client{
+		Transport: &auditTransport{
+			transport: http.DefaultTransport,
+			apiURL:    apiURL,
+			log:       &auditLog{path: path},
+		},
+	}
+
+	requests := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{"POST", basePath + "vars", `{"data":{"type":"vars","attributes":{"value":"secret"}}}`},
+		{"GET", basePath + "vars/var-123", ""},
+		{"DELETE", basePath + "vars/var-123", ""},
+		{"PUT", "/_archivist/v1/object/secret", ""},
+	}
+
+	for _, r := range requests {
+		req, err := http.NewRequest(r.method, ts.URL+r.path, strings.NewReader(r.body))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/vnd.api+json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Fatalf("secret was written to the audit log:\n%s", data)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 entries, got %d:\n%s", len(lines), data)
+	}
+
+	expected := []auditEntry{
+		{Method: "POST", Path: basePath + "vars", Status: 201, Type: "vars", ID: "var-123"},
+		{Method: "DELETE", Path: basePath + "vars/var-123", Status: 204, ID: "var-123"},
+		{Method: "PUT", Path: redacted, Status: 200},
+	}
+	for i, line := range lines {
+		var entry auditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if entry.Time == "" {
+			t.Fatalf("expected a timestamp: %s", line)
+		}
+		entry.Time = ""
+		if entry != expected[i] {
+			t.Fatalf("expected entry %+v, got %+v", expected[i], entry)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package tfe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// redactedHeaders are the headers whose values are never logged.
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Cookie":              true,
+	"Proxy-Authorization": true,
+	"Set-Cookie":          true,
+}
+
+// redactedAttributes are the JSON:API attributes whose values are never
+// logged, as they contain tokens, keys or other secrets.
+var redactedAttributes = map[string]bool{
+	"hmac-key":           true,
+	"oauth-token-string": true,
+	"password":           true,
+	"private-key":        true,
+	"secret":             true,
+	"token":              true,
+}
+
+// loggingTransport is a http.RoundTripper that logs the requests sent to and
+// the responses received from Terraform Enterprise, with all secrets
+// redacted. It is only used when debug logging is enabled.
+type loggingTransport struct {
+	transport http.RoundTripper
+	apiURL    *url.URL
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests to other URLs, like uploads and state downloads, are sent to
+	// pre-signed URLs and contain configurations, binaries or state. Neither
+	// their URL nor their bodies are logged.
+	isAPI := isAPIRequest(req, t.apiURL)
+	reqURL := redactedURL(req, t.apiURL)
+
+	// Read the body so it can be logged, and replace it with a copy in the
+	// request we send, as a RoundTripper must not modify the request.
+	var reqBody []byte
+	if isAPI && req.Body != nil && isJSON(req.Header) {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		r := req.WithContext(req.Context())
+		r.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(reqBody)), nil
+		}
+		req = r
+	}
+
+	log.Printf("[DEBUG] TFE API request: %s %s\n%s%s",
+		req.Method, reqURL, formatHeaders(req.Header), formatBody(req.Header, reqBody, req.ContentLength))
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	duration := time.Since(start)
+	if err != nil {
+		log.Printf("[DEBUG] TFE API request %s %s failed after %s: %v", req.Method, reqURL, duration, err)
+		return resp, err
+	}
+
+	var respBody []byte
+	if isAPI && isJSON(resp.Header) {
+		respBody, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+	}
+
+	log.Printf("[DEBUG] TFE API response: %s %s returned %s in %s (request ID: %s)\n%s%s",
+		req.Method, reqURL, resp.Status, duration, requestID(resp.Header),
+		formatHeaders(resp.Header), formatBody(resp.Header, respBody, resp.ContentLength))
+
+	return resp, nil
+}
+
+// redactedURL returns the URL of the request to log. The credentials of
+// pre-signed URLs are part of their path or query, so only the host of
+// requests outside the API is logged.
+func redactedURL(req *http.Request, apiURL *url.URL) string {
+	if isAPIRequest(req, apiURL) {
+		return req.URL.String()
+	}
+	u := url.URL{Scheme: req.URL.Scheme, Host: req.URL.Host}
+	return u.String() + "/" + redacted
+}
+
+// requestID returns the ID the API assigned to a request, which is needed
+// when asking for support about a failed request.
+func requestID(h http.Header) string {
+	if id := h.Get("X-Request-Id"); id != "" {
+		return id
+	}
+	return "none"
+}
+
+// isJSON returns whether the headers describe a JSON (or JSON:API) body.
+// Other bodies, like configuration uploads and logs, are not logged.
+func isJSON(h http.Header) bool {
+	return strings.Contains(h.Get("Content-Type"), "json")
+}
+
+// formatHeaders returns the headers in a stable order, one per line, with
+// the values of sensitive headers redacted.
+func formatHeaders(h http.Header) string {
+	var names []string
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	for _, name := range names {
+		value := strings.Join(h[name], ", ")
+		if redactedHeaders[http.CanonicalHeaderKey(name)] {
+			value = redacted
+		}
+		fmt.Fprintf(&buf, "%s: %s\n", name, value)
+	}
+
+	return buf.String()
+}
+
+// formatBody returns the body to log. JSON bodies are logged with all
+// secrets redacted, for other bodies only their size is logged.
+func formatBody(h http.Header, body []byte, size int64) string {
+	if !isJSON(h) || body == nil {
+		if size > 0 {
+			return fmt.Sprintf("[%d bytes]", size)
+		}
+		return ""
+	}
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		// Don't log a body we can't redact.
+		return fmt.Sprintf("[%d bytes of invalid JSON]", len(body))
+	}
+
+	out, err := json.MarshalIndent(redactJSON(v, ""), "", "  ")
+	if err != nil {
+		return fmt.Sprintf("[%d bytes]", len(body))
+	}
+
+	return string(out)
+}
+
+// redactJSON replaces the values of sensitive attributes in a decoded JSON
+// document. The JSON:API type of the enclosing resource is passed along, as
+// whether a value is sensitive can depend on the type of the resource.
+func redactJSON(v interface{}, resourceType string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if t, ok := v["type"].(string); ok {
+			resourceType = t
+		}
+		for key, value := range v {
+			if isSensitiveAttribute(v, key, resourceType) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(value, resourceType)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSON(value, resourceType)
+		}
+	}
+
+	return v
+}
+
+// isSensitiveAttribute returns whether the value of key in attrs must be
+// redacted. The value of a variable is only sensitive when the variable is
+// marked as sensitive, while the value of an SSH key is always sensitive.
+func isSensitiveAttribute(attrs map[string]interface{}, key, resourceType string) bool {
+	if redactedAttributes[key] {
+		return true
+	}
+
+	// Upload and download URLs are pre-signed, so they are credentials.
+	if _, ok := attrs[key].(string); ok &&
+		(strings.Contains(key, "upload") || strings.Contains(key, "download")) {
+		return true
+	}
+
+	if key != "value" {
+		return false
+	}
+	if sensitive, ok := attrs["sensitive"].(bool); ok && sensitive {
+		return true
+	}
+	return resourceType == "ssh-keys"
+}
@@ -0,0 +1,147 @@
+package tfe
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), "private-ssh-key") {
+			t.Errorf("request body was not sent: %q", body)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"data":{"type":"authentication-tokens","attributes":{"token":"secret-token"}}}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: testLoggingTransport(t, ts.URL)}
+
+	req, err := http.NewRequest("POST", ts.URL+"/api/v2/organizations/foo/ssh-keys",
+		strings.NewReader(`{"data":{"type":"ssh-keys","attributes":{"name":"key","value":"private-ssh-key"}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-auth")
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The response body must still be readable after it was logged.
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "secret-token") {
+		t.Fatalf("response body was not passed on: %q", body)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"secret-auth", "private-ssh-key", "secret-token"} {
+		if strings.Contains(out, secret) {
+			t.Fatalf("secret %q was logged:\n%s", secret, out)
+		}
+	}
+	for _, expected := range []string{"POST " + ts.URL, "201 Created", "req-123", `"name": "key"`} {
+		if !strings.Contains(out, expected) {
+			t.Fatalf("expected %q to be logged:\n%s", expected, out)
+		}
+	}
+}
+
+func TestLoggingTransport_presignedURL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"resources":[{"instances":[{"attributes":{"password":"state-secret"}}]}]}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: testLoggingTransport(t, ts.URL)}
+
+	resp, err := client.Get(ts.URL + "/_archivist/v1/object/signed-path?signature=signed-query")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The state must still be readable after the response was logged.
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "state-secret") {
+		t.Fatalf("response body was not passed on: %q", body)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"signed-path", "signed-query", "state-secret", "instances"} {
+		if strings.Contains(out, secret) {
+			t.Fatalf("secret %q was logged:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "GET "+ts.URL+"/"+redacted) {
+		t.Fatalf("expected the redacted URL to be logged:\n%s", out)
+	}
+}
+
+// testLoggingTransport returns a logging transport for the API served by
+// the test server on address.
+func testLoggingTransport(t *testing.T, address string) *loggingTransport {
+	apiURL, err := apiBaseURL(address, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &loggingTransport{transport: http.DefaultTransport, apiURL: apiURL}
+}
+
+func TestRedactJSON(t *testing.T) {
+	cases := map[string]struct {
+		body     string
+		redacted bool
+	}{
+		"sensitive variable": {
+			body:     `{"data":{"type":"vars","attributes":{"key":"k","value":"v","sensitive":true}}}`,
+			redacted: true,
+		},
+		"variable": {
+			body:     `{"data":{"type":"vars","attributes":{"key":"k","value":"v","sensitive":false}}}`,
+			redacted: false,
+		},
+		"ssh key": {
+			body:     `{"data":{"type":"ssh-keys","attributes":{"name":"k","value":"v"}}}`,
+			redacted: true,
+		},
+		"upload url": {
+			body:     `{"data":{"type":"configuration-versions","attributes":{"upload-url":"v"}}}`,
+			redacted: true,
+		},
+		"run task": {
+			body:     `{"data":[{"type":"tasks","attributes":{"name":"k","hmac-key":"v"}}]}`,
+			redacted: true,
+		},
+	}
+
+	h := http.Header{"Content-Type": []string{"application/vnd.api+json"}}
+	for name, tc := range cases {
+		out := formatBody(h, []byte(tc.body), int64(len(tc.body)))
+		if strings.Contains(out, `"v"`) == tc.redacted {
+			t.Fatalf("%s: expected redacted to be %t, got:\n%s", name, tc.redacted, out)
+		}
+	}
+}
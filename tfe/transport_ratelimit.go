@@ -0,0 +1,109 @@
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	defaultRequestsPerSecond = 30
+	defaultRequestsBurst     = 30
+)
+
+// rateLimitTransport is a http.RoundTripper that limits the rate at which
+// requests are sent. All resources of a provider instance share the same
+// transport, so the limit applies to the provider as a whole.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	limiter   *tokenBucket
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// tokenBucket implements a token bucket rate limiter. The bucket holds up to
+// burst tokens and is refilled with rate tokens per second.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or the context is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+
+	// Refill the bucket for the time passed since the last request.
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	// Take a token, which may leave the bucket with a debt that determines
+	// how long we need to wait before the token is actually ours.
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+
+	b.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Return the token we didn't use.
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// floatAtLeast returns a SchemaValidateFunc which tests if the provided value
+// is of type float64 and is at least min (inclusive).
+func floatAtLeast(min float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(float64)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be float", k))
+			return
+		}
+
+		if v < min {
+			es = append(es, fmt.Errorf("expected %s to be at least (%g), got %g", k, min, v))
+			return
+		}
+
+		return
+	}
+}
@@ -0,0 +1,65 @@
+package tfe
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_wait(t *testing.T) {
+	b := newTokenBucket(100, 5)
+
+	// The burst is available immediately.
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := b.wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Fatalf("expected the burst to be available immediately, took: %s", elapsed)
+	}
+
+	// Any following requests are limited to the rate.
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		if err := b.wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("expected requests to be rate limited, took: %s", elapsed)
+	}
+}
+
+func TestTokenBucket_canceled(t *testing.T) {
+	b := newTokenBucket(0.1, 1)
+
+	if err := b.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := b.wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestFloatAtLeast(t *testing.T) {
+	cases := map[float64]bool{
+		30:   true,
+		0.5:  true,
+		0:    true,
+		-1:   false,
+		-0.1: false,
+	}
+
+	for v, valid := range cases {
+		_, errs := floatAtLeast(0)(v, "requests_per_second")
+		if (len(errs) == 0) != valid {
+			t.Fatalf("%g: expected valid to be %t, got: %v", v, valid, errs)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package tfe
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// readOnlyTransport is a http.RoundTripper that only allows requests that
+// read objects, so a provider configured for read only use can never change
+// anything, even when a plan is applied by mistake.
+type readOnlyTransport struct {
+	transport http.RoundTripper
+	apiURL    *url.URL
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "GET" {
+		// A RoundTripper must always close the body, even on errors.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf(
+			"The provider is configured with read_only = true and refused to %s %s",
+			req.Method, redactedPath(req, t.apiURL))
+	}
+	return t.transport.RoundTrip(req)
+}
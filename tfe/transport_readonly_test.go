@@ -0,0 +1,55 @@
+package tfe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	apiURL, err := apiBaseURL(ts.URL, "/tfe/v2/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client := &http.Client{
+		Transport: &readOnlyTransport{
+			transport: http.DefaultTransport,
+			apiURL:    apiURL,
+		},
+	}
+
+	resp, err := client.Get(ts.URL + "/tfe/v2/workspaces/ws-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	for _, method := range []string{"POST", "PATCH", "PUT", "DELETE"} {
+		req, err := http.NewRequest(method, ts.URL+"/tfe/v2/workspaces/ws-123", strings.NewReader("{}"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_, err = client.Do(req)
+		if err == nil {
+			t.Fatalf("%s: expected an error", method)
+		}
+		if !strings.Contains(err.Error(), "read_only") ||
+			!strings.Contains(err.Error(), method+" /tfe/v2/workspaces/ws-123") {
+			t.Fatalf("%s: unexpected error: %v", method, err)
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected only the GET request to be sent, got %d requests", requests)
+	}
+}
@@ -0,0 +1,203 @@
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 5
+	defaultRetryWaitMin = 1
+	defaultRetryWaitMax = 30
+)
+
+// retryTransport is a http.RoundTripper that retries requests which failed
+// because of rate limiting, server errors or dropped connections.
+type retryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+// streamedBodyKey is the context key that marks a request body as streamed.
+type streamedBodyKey struct{}
+
+// withStreamedBody returns a context for requests whose body is streamed from
+// a file, like the registry uploads. Streamed bodies are never buffered in
+// memory, so these requests are not retried.
+func withStreamedBody(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamedBodyKey{}, true)
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Buffer the body of API requests, so it can be sent again when retrying.
+	streamed, _ := req.Context().Value(streamedBodyKey{}).(bool)
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil && !streamed {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		// A RoundTripper must not modify the request, so use a copy.
+		r := req.WithContext(req.Context())
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		req = r
+	}
+
+	canRetry := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		// A RoundTripper must not modify the request, so use a copy.
+		r := req.WithContext(req.Context())
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(r)
+		if !canRetry || attempt >= t.maxRetries || !shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed, retrying in %s: %v", req.Method, req.URL, wait, err)
+		} else {
+			log.Printf("[DEBUG] %s %s returned %s, retrying in %s", req.Method, req.URL, resp.Status, wait)
+
+			// Drain and close the body so the connection can be reused.
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt. The rate limit
+// headers returned by the API take precedence, otherwise an exponential
+// backoff with some jitter is used.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header); ok {
+			return wait
+		}
+	}
+
+	wait := float64(t.waitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(t.waitMax) {
+		wait = float64(t.waitMax)
+	}
+
+	// Add up to 20% of jitter so concurrent requests don't retry in lockstep.
+	wait += wait * 0.2 * rand.Float64()
+
+	return time.Duration(wait)
+}
+
+// shouldRetry returns whether a request should be retried based on the
+// response or error of the last attempt. A rate limited request was never
+// processed, so it is always safe to send again. Other failures are only
+// retried for idempotent requests, as e.g. a failed POST may still have
+// created an object.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if !isIdempotent(method) {
+		return false
+	}
+
+	if err != nil {
+		return isRetryableError(err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotImplemented:
+		return false
+	case resp.StatusCode >= 500:
+		return true
+	}
+
+	return false
+}
+
+// isIdempotent returns whether sending a request with the given method more
+// than once has the same effect as sending it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "TRACE", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// isRetryableError returns whether err is a transient network error.
+func isRetryableError(err error) bool {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+
+	msg := err.Error()
+	return strings.Contains(msg, "connection reset") ||
+		strings.Contains(msg, "broken pipe") ||
+		strings.Contains(msg, "EOF")
+}
+
+// retryAfter parses the Retry-After and X-RateLimit-* headers and returns
+// how long the API asked us to wait.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			wait := time.Until(date)
+			if wait < 0 {
+				wait = 0
+			}
+			return wait, true
+		}
+	}
+
+	// The reset header contains the (fractional) number of seconds until
+	// the rate limit resets, which only matters if there are no requests
+	// remaining in the current window.
+	if h.Get("X-RateLimit-Remaining") == "0" {
+		if v := h.Get("X-RateLimit-Reset"); v != "" {
+			if seconds, err := strconv.ParseFloat(v, 64); err == nil && seconds >= 0 {
+				return time.Duration(seconds * float64(time.Second)), true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,264 @@
+package tfe
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tfe "github.com/HappyPathway/go-tfe"
+)
+
+func TestRetryTransport_retries(t *testing.T) {
+	cases := map[string]struct {
+		call     func(*tfe.Client) error
+		status   int
+		attempts int
+	}{
+		"rate limited get": {
+			call:     testReadTeam,
+			status:   http.StatusTooManyRequests,
+			attempts: 3,
+		},
+		"rate limited post": {
+			call:     testCreateTeam,
+			status:   http.StatusTooManyRequests,
+			attempts: 3,
+		},
+		"rate limited patch": {
+			call:     testUpdateWorkspace,
+			status:   http.StatusTooManyRequests,
+			attempts: 3,
+		},
+		"server error get": {
+			call:     testReadTeam,
+			status:   http.StatusBadGateway,
+			attempts: 3,
+		},
+		"server error delete": {
+			call:     testDeleteTeam,
+			status:   http.StatusBadGateway,
+			attempts: 3,
+		},
+		"server error post": {
+			call:     testCreateTeam,
+			status:   http.StatusBadGateway,
+			attempts: 1,
+		},
+		"server error patch": {
+			call:     testUpdateWorkspace,
+			status:   http.StatusBadGateway,
+			attempts: 1,
+		},
+		"not implemented": {
+			call:     testReadTeam,
+			status:   http.StatusNotImplemented,
+			attempts: 1,
+		},
+		"not found": {
+			call:     testReadTeam,
+			status:   http.StatusNotFound,
+			attempts: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		attempts := 0
+		var firstBody string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+
+			// Make sure the same body is sent with every attempt.
+			body, _ := ioutil.ReadAll(r.Body)
+			if attempts == 1 {
+				firstBody = string(body)
+			} else if string(body) != firstBody {
+				t.Errorf("%s: bad body in attempt %d: %q", name, attempts, body)
+			}
+			if (r.Method == "POST" || r.Method == "PATCH") && len(body) == 0 {
+				t.Errorf("%s: expected a body in attempt %d", name, attempts)
+			}
+
+			if attempts < 3 {
+				w.WriteHeader(tc.status)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			if r.Method == "DELETE" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			if strings.Contains(r.URL.Path, "/workspaces/") {
+				w.Write([]byte(`{"data": {"type": "workspaces", "id": "ws-123", "attributes": {"name": "workspace-test"}}}`))
+				return
+			}
+			w.Write([]byte(`{"data": {"type": "teams", "id": "team-123", "attributes": {"name": "team-test"}}}`))
+		}))
+
+		// Use a go-tfe client, so the requests are built in the same way
+		// as the requests sent by the provider.
+		tfeClient, err := tfe.NewClient(&tfe.Config{
+			Address: ts.URL,
+			Token:   "secret",
+			HTTPClient: &http.Client{
+				Transport: &retryTransport{
+					transport:  http.DefaultTransport,
+					maxRetries: 5,
+					waitMin:    time.Millisecond,
+					waitMax:    10 * time.Millisecond,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		err = tc.call(tfeClient)
+		ts.Close()
+		if tc.attempts == 3 && err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		if attempts != tc.attempts {
+			t.Fatalf("%s: expected %d attempts, got: %d", name, tc.attempts, attempts)
+		}
+	}
+}
+
+func testReadTeam(tfeClient *tfe.Client) error {
+	_, err := tfeClient.Teams.Read(ctx, "team-123")
+	return err
+}
+
+func testCreateTeam(tfeClient *tfe.Client) error {
+	_, err := tfeClient.Teams.Create(ctx, "terraform-test", tfe.TeamCreateOptions{
+		Name: tfe.String("team-test"),
+	})
+	return err
+}
+
+func testDeleteTeam(tfeClient *tfe.Client) error {
+	return tfeClient.Teams.Delete(ctx, "team-123")
+}
+
+func testUpdateWorkspace(tfeClient *tfe.Client) error {
+	_, err := tfeClient.Workspaces.Update(ctx, "terraform-test", "workspace-test", tfe.WorkspaceUpdateOptions{
+		Description: tfe.String("updated"),
+	})
+	return err
+}
+
+func TestRetryTransport_maxRetries(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			transport:  http.DefaultTransport,
+			maxRetries: 2,
+			waitMin:    time.Millisecond,
+			waitMax:    10 * time.Millisecond,
+		},
+	}
+
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected status %d, got: %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got: %d", attempts)
+	}
+}
+
+func TestRetryTransport_streamedBody(t *testing.T) {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "registry")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "SHA256SUMS")
+	if err := ioutil.WriteFile(path, []byte("payload"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client := &http.Client{
+		Transport: &retryTransport{
+			transport:  http.DefaultTransport,
+			maxRetries: 2,
+			waitMin:    time.Millisecond,
+			waitMax:    10 * time.Millisecond,
+		},
+	}
+
+	// Uploads are streamed from the file, so they are never retried.
+	err = uploadRegistryFile(ctx, client, ts.URL, path)
+	if err == nil || !strings.Contains(err.Error(), "429") {
+		t.Fatalf("expected an error with the status, got: %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got: %d", attempts)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	cases := map[string]struct {
+		header http.Header
+		wait   time.Duration
+		ok     bool
+	}{
+		"retry after seconds": {
+			header: http.Header{"Retry-After": []string{"3"}},
+			wait:   3 * time.Second,
+			ok:     true,
+		},
+		"rate limit reset": {
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"0.5"},
+			},
+			wait: 500 * time.Millisecond,
+			ok:   true,
+		},
+		"rate limit remaining": {
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"10"},
+				"X-Ratelimit-Reset":     []string{"0.5"},
+			},
+		},
+		"no headers": {
+			header: http.Header{},
+		},
+	}
+
+	for name, tc := range cases {
+		wait, ok := retryAfter(tc.header)
+		if ok != tc.ok {
+			t.Fatalf("%s: expected ok to be %t, got: %t", name, tc.ok, ok)
+		}
+		if wait != tc.wait {
+			t.Fatalf("%s: expected wait %s, got: %s", name, tc.wait, wait)
+		}
+	}
+}